@@ -0,0 +1,231 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/resources"
+)
+
+var (
+	file    string
+	dirs    []string
+	match   string
+	jsonOut bool
+)
+
+// unusedTerm is a glossary term found in no source string.
+type unusedTerm struct {
+	Term string `json:"term"`
+	Row  int    `json:"row"`
+}
+
+type auditResult struct {
+	Files   int          `json:"files"`
+	Strings int          `json:"strings"`
+	Terms   int          `json:"terms"`
+	Unused  []unusedTerm `json:"unused"`
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Report glossary terms that appear in no source string of your translation files",
+	Long: `Scan translation files (JSON, YAML and Apple .strings) for the source strings
+of your product and report glossary terms that none of them uses, so dead
+entries can be pruned before an upload.
+
+Only string values are read; keys are identifiers, not copy. Point --dir at
+the source language, or narrow the files with --match. Terms match as whole
+words or phrases, ignoring case unless casesensitive is yes. Forbidden terms
+are not reported: they are meant to be absent.
+
+Examples:
+  glossary-guard audit -f glossary.csv --dir locales/en
+  glossary-guard audit -f glossary.csv --dir locales --match "en.json" --json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if len(dirs) == 0 {
+			return fmt.Errorf("--dir is required")
+		}
+		if match == "" {
+			match = "*"
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		tbl, err := csvutil.Parse(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if tbl == nil || tbl.Col("term") < 0 {
+			return fmt.Errorf("%s: no term column found", file)
+		}
+
+		paths, err := resources.Find(dirs, match)
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			return fmt.Errorf("no translation files (%s) matching %q found", resources.Extensions, match)
+		}
+		var texts []string
+		for _, p := range paths {
+			raw, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			es, err := resources.Read(p, raw)
+			if err != nil {
+				return err
+			}
+			for _, e := range es {
+				texts = append(texts, e.Value)
+			}
+		}
+
+		res := audit(tbl, texts)
+		res.Files = len(paths)
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(res); err != nil {
+				return err
+			}
+		} else {
+			printResult(res)
+		}
+		if len(res.Unused) > 0 {
+			return fmt.Errorf("found %d unused term(s)", len(res.Unused))
+		}
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	auditCmd.Flags().StringVarP(&file, "file", "f", "", "Glossary CSV file to audit")
+	auditCmd.Flags().StringSliceVar(&dirs, "dir", nil, "Directory of translation files, searched recursively (comma-separated or repeatable)")
+	auditCmd.Flags().StringVar(&match, "match", "*", "Only read translation files whose name matches this glob (e.g. \"en.json\")")
+	auditCmd.Flags().BoolVar(&jsonOut, "json", false, "Output the audit as JSON (machine-readable)")
+
+	root.AddCommand(auditCmd)
+}
+
+// audit lists the terms of tbl that appear in none of texts.
+func audit(tbl *csvutil.Table, texts []string) auditResult {
+	termCol, csCol, forbiddenCol := tbl.Col("term"), tbl.Col("casesensitive"), tbl.Col("forbidden")
+	flag := func(row csvutil.Row, c int) string {
+		if c < 0 {
+			return ""
+		}
+		return strings.ToLower(strings.TrimSpace(row.Get(c)))
+	}
+
+	type term struct {
+		text string
+		row  int
+		cs   bool
+	}
+	var terms []term
+	maxWords := 1
+	for _, row := range tbl.Rows {
+		t := strings.TrimSpace(row.Get(termCol))
+		if t == "" || flag(row, forbiddenCol) == "yes" {
+			continue
+		}
+		terms = append(terms, term{t, row.Line, flag(row, csCol) == "yes"})
+		maxWords = max(maxWords, len(words(t)))
+	}
+
+	idx := newIndex(texts, maxWords)
+	res := auditResult{Strings: len(texts), Terms: len(terms), Unused: []unusedTerm{}}
+	for _, t := range terms {
+		if !idx.contains(t.text, t.cs) {
+			res.Unused = append(res.Unused, unusedTerm{Term: t.text, Row: t.row})
+		}
+	}
+	return res
+}
+
+// index answers whether a term occurs in a set of texts. Terms made of
+// words are looked up among the word sequences of the texts, so "log in"
+// matches "Log in to continue" but not "login"; other terms ("C#", ".NET")
+// are searched as substrings.
+type index struct {
+	texts       []string
+	exact, fold map[string]struct{}
+}
+
+func newIndex(texts []string, maxWords int) *index {
+	idx := &index{texts: texts, exact: map[string]struct{}{}, fold: map[string]struct{}{}}
+	for _, s := range texts {
+		ws := words(s)
+		for i := range ws {
+			for n := 1; n <= maxWords && i+n <= len(ws); n++ {
+				seq := strings.Join(ws[i:i+n], " ")
+				idx.exact[seq] = struct{}{}
+				idx.fold[strings.ToLower(seq)] = struct{}{}
+			}
+		}
+	}
+	return idx
+}
+
+func (idx *index) contains(term string, caseSensitive bool) bool {
+	if plain(term) {
+		seq := strings.Join(words(term), " ")
+		if caseSensitive {
+			_, ok := idx.exact[seq]
+			return ok
+		}
+		_, ok := idx.fold[strings.ToLower(seq)]
+		return ok
+	}
+	for _, s := range idx.texts {
+		if caseSensitive && strings.Contains(s, term) ||
+			!caseSensitive && strings.Contains(strings.ToLower(s), strings.ToLower(term)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return !isWordRune(r) })
+}
+
+// plain reports whether term is only words separated by spaces, hyphens or
+// apostrophes, which the word lookup handles.
+func plain(term string) bool {
+	for _, r := range term {
+		if !isWordRune(r) && !unicode.IsSpace(r) && r != '-' && r != '\'' && r != '’' {
+			return false
+		}
+	}
+	return len(words(term)) > 0
+}
+
+func printResult(r auditResult) {
+	if len(r.Unused) == 0 {
+		fmt.Printf("All %d term(s) appear in %d string(s) from %d file(s).\n", r.Terms, r.Strings, r.Files)
+		return
+	}
+	for _, u := range r.Unused {
+		fmt.Printf("→ %q (row %d)\n", u.Term, u.Row)
+	}
+	fmt.Printf("\n%d of %d term(s) appear in none of %d string(s) from %d file(s)\n", len(r.Unused), r.Terms, r.Strings, r.Files)
+}
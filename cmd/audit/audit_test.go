@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+)
+
+func TestAudit(t *testing.T) {
+	tbl, err := csvutil.Parse([]byte("term;description;casesensitive;forbidden\n" +
+		"log in;d;no;no\n" +
+		"Project;d;yes;no\n" +
+		"save file;d;no;no\n" +
+		"C#;d;no;no\n" +
+		"whitelist;d;no;yes\n" +
+		"webhook;d;no;no\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := []string{"Log in to continue", "Open project", "Saved files", "Use the C# SDK", "Login"}
+	res := audit(tbl, texts)
+	want := []unusedTerm{{"Project", 3}, {"save file", 4}, {"webhook", 7}}
+	if res.Terms != 5 || res.Strings != 5 || !reflect.DeepEqual(res.Unused, want) {
+		t.Fatalf("audit = %+v, want unused %+v", res, want)
+	}
+}
@@ -0,0 +1,181 @@
+package conflicts
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+)
+
+var (
+	files   []string
+	langs   []string
+	jsonOut bool
+)
+
+type variant struct {
+	Translation string   `json:"translation"`
+	Files       []string `json:"files"`
+}
+
+type conflict struct {
+	Term     string    `json:"term"`
+	Lang     string    `json:"lang"`
+	Variants []variant `json:"variants"`
+}
+
+type glossary struct {
+	path string
+	tbl  *csvutil.Table
+}
+
+var conflictsCmd = &cobra.Command{
+	Use:   "conflicts",
+	Short: "Report terms translated differently across two or more glossaries",
+	Long: `Compare glossaries (e.g. product A vs product B) and report terms whose
+target-language translations disagree, which leads to inconsistent UI copy across products.
+
+Terms are matched exactly (after trimming); language columns are matched by header name.
+Empty translations never conflict.
+
+Examples:
+  glossary-guard conflicts -f product-a.csv -f product-b.csv
+  glossary-guard conflicts -f "glossaries/*.csv" -l de -l fr --json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		paths := append(append([]string(nil), files...), args...)
+		if len(paths) < 2 {
+			return fmt.Errorf("need at least two glossaries to compare")
+		}
+
+		gs := make([]glossary, 0, len(paths))
+		for _, p := range paths {
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			tbl, err := csvutil.Parse(data)
+			if err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+			if tbl == nil {
+				return fmt.Errorf("%s: no header found", p)
+			}
+			gs = append(gs, glossary{path: p, tbl: tbl})
+		}
+
+		found := findConflicts(gs, langs)
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(found); err != nil {
+				return err
+			}
+		} else {
+			printConflicts(found, len(gs))
+		}
+
+		if len(found) > 0 {
+			return fmt.Errorf("found %d conflicting translation(s)", len(found))
+		}
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	conflictsCmd.Flags().StringSliceVarP(&files, "files", "f", nil, "Glossary files to compare (comma-separated or repeatable)")
+	conflictsCmd.Flags().StringSliceVarP(&langs, "langs", "l", nil, "Only compare these language columns (default: all shared)")
+	conflictsCmd.Flags().BoolVar(&jsonOut, "json", false, "Output conflicts as JSON (machine-readable)")
+
+	root.AddCommand(conflictsCmd)
+}
+
+// findConflicts groups translations by term and language and keeps the
+// groups that have more than one distinct non-empty translation.
+func findConflicts(gs []glossary, onlyLangs []string) []conflict {
+	allowed := map[string]struct{}{}
+	for _, l := range onlyLangs {
+		for _, part := range strings.Split(l, ",") {
+			if p := strings.TrimSpace(part); p != "" {
+				allowed[p] = struct{}{}
+			}
+		}
+	}
+
+	type key struct{ term, lang string }
+	// key -> translation -> files (in input order, deduplicated)
+	groups := map[key]map[string][]string{}
+
+	for _, g := range gs {
+		termCol := g.tbl.Col("term")
+		if termCol < 0 {
+			continue
+		}
+		langCols := g.tbl.LangCols()
+		for _, row := range g.tbl.Rows {
+			term := strings.TrimSpace(row.Get(termCol))
+			if term == "" {
+				continue
+			}
+			for _, c := range langCols {
+				lang := strings.TrimSpace(g.tbl.Header[c])
+				if len(allowed) > 0 {
+					if _, ok := allowed[lang]; !ok {
+						continue
+					}
+				}
+				tr := strings.TrimSpace(row.Get(c))
+				if tr == "" {
+					continue
+				}
+				k := key{term, lang}
+				if groups[k] == nil {
+					groups[k] = map[string][]string{}
+				}
+				fs := groups[k][tr]
+				if len(fs) == 0 || fs[len(fs)-1] != g.path {
+					groups[k][tr] = append(fs, g.path)
+				}
+			}
+		}
+	}
+
+	var out []conflict
+	for k, trs := range groups {
+		if len(trs) < 2 {
+			continue
+		}
+		c := conflict{Term: k.term, Lang: k.lang}
+		for tr, fs := range trs {
+			c.Variants = append(c.Variants, variant{Translation: tr, Files: fs})
+		}
+		sort.Slice(c.Variants, func(i, j int) bool { return c.Variants[i].Translation < c.Variants[j].Translation })
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Term != out[j].Term {
+			return out[i].Term < out[j].Term
+		}
+		return out[i].Lang < out[j].Lang
+	})
+	return out
+}
+
+func printConflicts(cs []conflict, filesCount int) {
+	if len(cs) == 0 {
+		fmt.Printf("No conflicting translations across %d glossaries.\n", filesCount)
+		return
+	}
+	for _, c := range cs {
+		fmt.Printf("→ %q [%s]\n", c.Term, c.Lang)
+		for _, v := range c.Variants {
+			fmt.Printf("   %q in %s\n", v.Translation, strings.Join(v.Files, ", "))
+		}
+	}
+	fmt.Printf("\n%d conflicting translation(s) across %d glossaries\n", len(cs), filesCount)
+}
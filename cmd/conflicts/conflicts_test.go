@@ -0,0 +1,37 @@
+package conflicts
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+)
+
+func mustParse(t *testing.T, path, data string) glossary {
+	t.Helper()
+	tbl, err := csvutil.Parse([]byte(data))
+	if err != nil || tbl == nil {
+		t.Fatalf("parse %s: %v", path, err)
+	}
+	return glossary{path: path, tbl: tbl}
+}
+
+func TestFindConflicts(t *testing.T) {
+	a := mustParse(t, "a.csv", "term;description;de;fr\nsave;d;Speichern;Enregistrer\nlog in;d;Anmelden;\n")
+	b := mustParse(t, "b.csv", "term;description;de;fr\nsave;d;Sichern;Enregistrer\nlog in;d;Anmelden;Connexion\n")
+
+	got := findConflicts([]glossary{a, b}, nil)
+	if len(got) != 1 {
+		t.Fatalf("got %d conflicts, want 1: %+v", len(got), got)
+	}
+	c := got[0]
+	if c.Term != "save" || c.Lang != "de" || len(c.Variants) != 2 {
+		t.Fatalf("unexpected conflict: %+v", c)
+	}
+	if c.Variants[0].Translation != "Sichern" || c.Variants[0].Files[0] != "b.csv" {
+		t.Fatalf("unexpected variant order: %+v", c.Variants)
+	}
+
+	if got := findConflicts([]glossary{a, b}, []string{"fr"}); len(got) != 0 {
+		t.Fatalf("lang filter ignored: %+v", got)
+	}
+}
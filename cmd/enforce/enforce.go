@@ -0,0 +1,244 @@
+package enforce
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/resources"
+)
+
+var (
+	file    string
+	source  string
+	targets []string
+	jsonOut bool
+)
+
+// violation is a translated string that does not use the approved
+// equivalent of a term its source string contains.
+type violation struct {
+	Lang     string `json:"lang"`
+	Key      string `json:"key"`
+	Term     string `json:"term"`
+	Expected string `json:"expected"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+}
+
+var enforceCmd = &cobra.Command{
+	Use:   "enforce",
+	Short: "Flag translations that do not use the approved glossary equivalents",
+	Long: `Compare a source resource file with its translations (JSON, YAML or Apple
+.strings) and flag every translated string whose source contains a glossary
+term while the translation lacks the term's approved equivalent from the
+glossary's language column.
+
+Strings are paired by key. Terms match as whole words or phrases, ignoring
+case unless casesensitive is yes. Equivalents match ignoring case, and their
+last word may carry an ending ("Datei" also matches "Dateien"). Terms marked
+translatable=no must appear unchanged; forbidden terms and empty translations
+are not enforced.
+
+Examples:
+  glossary-guard enforce -f glossary.csv --source locales/en.json --target de=locales/de.json
+  glossary-guard enforce -f glossary.csv --source en.yml --target de=de.yml --target fr=fr.yml --json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if file == "" || source == "" || len(targets) == 0 {
+			return fmt.Errorf("--file, --source and at least one --target are required")
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		tbl, err := csvutil.Parse(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if tbl == nil || tbl.Col("term") < 0 {
+			return fmt.Errorf("%s: no term column found", file)
+		}
+		src, err := readEntries(source)
+		if err != nil {
+			return err
+		}
+
+		var found []violation
+		for _, t := range targets {
+			lang, path, ok := strings.Cut(t, "=")
+			if !ok || lang == "" || path == "" {
+				return fmt.Errorf("invalid --target %q (expected <lang>=<file>)", t)
+			}
+			col := langCol(tbl, lang)
+			if col < 0 {
+				return fmt.Errorf("%s: no %q language column", file, lang)
+			}
+			tgt, err := readEntries(path)
+			if err != nil {
+				return err
+			}
+			found = append(found, enforce(tbl, col, src, tgt)...)
+		}
+
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if found == nil {
+				found = []violation{}
+			}
+			if err := enc.Encode(found); err != nil {
+				return err
+			}
+		} else {
+			printViolations(found, len(targets))
+		}
+		if len(found) > 0 {
+			return fmt.Errorf("found %d translation(s) not using the glossary", len(found))
+		}
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	enforceCmd.Flags().StringVarP(&file, "file", "f", "", "Glossary CSV file with the approved translations")
+	enforceCmd.Flags().StringVar(&source, "source", "", "Source language resource file ("+resources.Extensions+")")
+	enforceCmd.Flags().StringArrayVar(&targets, "target", nil, "Translated resource file as <lang>=<file>, where <lang> is a glossary column (repeatable)")
+	enforceCmd.Flags().BoolVar(&jsonOut, "json", false, "Output violations as JSON (machine-readable)")
+
+	root.AddCommand(enforceCmd)
+}
+
+func readEntries(path string) ([]resources.Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return resources.Read(path, data)
+}
+
+// langCol finds the column of lang, treating "-" and "_" alike.
+func langCol(tbl *csvutil.Table, lang string) int {
+	norm := func(s string) string { return strings.ReplaceAll(csvutil.NormalizeHeader(s), "-", "_") }
+	for _, c := range tbl.LangCols() {
+		if norm(tbl.Header[c]) == norm(lang) {
+			return c
+		}
+	}
+	return -1
+}
+
+// rule is a term with its approved equivalent in one language.
+type rule struct {
+	term, expected string
+	words          []string // of term; lowercased unless caseSensitive
+	caseSensitive  bool
+	want           []string // of expected, lowercased
+}
+
+// enforce checks the target strings paired by key with src against the
+// approved translations in column col.
+func enforce(tbl *csvutil.Table, col int, src, tgt []resources.Entry) []violation {
+	termCol := tbl.Col("term")
+	csCol, trCol, fbCol := tbl.Col("casesensitive"), tbl.Col("translatable"), tbl.Col("forbidden")
+	flag := func(row csvutil.Row, c int) string {
+		if c < 0 {
+			return ""
+		}
+		return strings.ToLower(strings.TrimSpace(row.Get(c)))
+	}
+
+	var rules []rule
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		if term == "" || flag(row, fbCol) == "yes" {
+			continue
+		}
+		expected := strings.TrimSpace(row.Get(col))
+		if flag(row, trCol) == "no" {
+			expected = term
+		}
+		if expected == "" {
+			continue
+		}
+		r := rule{term: term, expected: expected, caseSensitive: flag(row, csCol) == "yes", want: words(expected, false)}
+		r.words = words(term, r.caseSensitive)
+		if len(r.words) > 0 && len(r.want) > 0 {
+			rules = append(rules, r)
+		}
+	}
+	lang := strings.TrimSpace(tbl.Header[col])
+
+	translated := make(map[string]string, len(tgt))
+	for _, e := range tgt {
+		translated[e.Key] = e.Value
+	}
+	var out []violation
+	for _, e := range src {
+		target, ok := translated[e.Key]
+		if !ok || strings.TrimSpace(target) == "" {
+			continue
+		}
+		srcExact, srcFold := words(e.Value, true), words(e.Value, false)
+		tgtFold := words(target, false)
+		for _, r := range rules {
+			in := srcFold
+			if r.caseSensitive {
+				in = srcExact
+			}
+			if !containsSeq(in, r.words, false) || containsSeq(tgtFold, r.want, true) {
+				continue
+			}
+			out = append(out, violation{Lang: lang, Key: e.Key, Term: r.term, Expected: r.expected, Source: e.Value, Target: target})
+		}
+	}
+	return out
+}
+
+// words splits s into words, lowercased unless exact.
+func words(s string, exact bool) []string {
+	if !exact {
+		s = strings.ToLower(s)
+	}
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+}
+
+// containsSeq reports whether seq occurs in ws as consecutive words. With
+// ending, the last word of seq may be the start of a longer word.
+func containsSeq(ws, seq []string, ending bool) bool {
+	last := len(seq) - 1
+	for i := 0; i+len(seq) <= len(ws); i++ {
+		ok := true
+		for j, w := range seq {
+			if ws[i+j] == w || (ending && j == last && strings.HasPrefix(ws[i+j], w)) {
+				continue
+			}
+			ok = false
+			break
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func printViolations(vs []violation, targetsCount int) {
+	if len(vs) == 0 {
+		fmt.Printf("All translations in %d target file(s) use the glossary.\n", targetsCount)
+		return
+	}
+	for _, v := range vs {
+		fmt.Printf("→ [%s] %s: %q should be translated as %q\n", v.Lang, v.Key, v.Term, v.Expected)
+		fmt.Printf("   source: %q\n", v.Source)
+		fmt.Printf("   target: %q\n", v.Target)
+	}
+	fmt.Printf("\n%d translation(s) in %d target file(s) do not use the glossary\n", len(vs), targetsCount)
+}
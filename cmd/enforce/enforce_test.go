@@ -0,0 +1,66 @@
+package enforce
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/resources"
+)
+
+func TestEnforce(t *testing.T) {
+	tbl, err := csvutil.Parse([]byte("term;description;casesensitive;translatable;forbidden;de-DE\n" +
+		"file;d;no;yes;no;Datei\n" +
+		"log in;d;no;yes;no;anmelden\n" +
+		"Acme Cloud;d;yes;no;no;\n" +
+		"whitelist;d;no;yes;yes;Whitelist\n" +
+		"folder;d;no;yes;no;\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	col := langCol(tbl, "de_de")
+	if col != 5 {
+		t.Fatalf("langCol = %d, want 5", col)
+	}
+
+	src := []resources.Entry{
+		{Key: "a", Value: "Delete files"},
+		{Key: "b", Value: "Log in to Acme Cloud"},
+		{Key: "c", Value: "Open the whitelist folder"},
+		{Key: "d", Value: "Upload a file"},
+		{Key: "e", Value: "acme cloud settings"},
+		{Key: "f", Value: "Rename file"},
+	}
+	tgt := []resources.Entry{
+		{Key: "a", Value: "Dateien löschen"},
+		{Key: "b", Value: "Bei Acme-Cloud einloggen"},
+		{Key: "c", Value: "Ordner der Sperrliste öffnen"},
+		{Key: "d", Value: "Eine Akte hochladen"},
+		{Key: "e", Value: "Einstellungen"},
+	}
+	got := enforce(tbl, col, src, tgt)
+	want := []violation{
+		{Lang: "de-DE", Key: "b", Term: "log in", Expected: "anmelden", Source: "Log in to Acme Cloud", Target: "Bei Acme-Cloud einloggen"},
+		{Lang: "de-DE", Key: "d", Term: "file", Expected: "Datei", Source: "Upload a file", Target: "Eine Akte hochladen"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("violation %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestContainsSeq(t *testing.T) {
+	ws := []string{"die", "dateien", "speichern"}
+	if !containsSeq(ws, []string{"dateien"}, false) || containsSeq(ws, []string{"datei"}, false) {
+		t.Error("exact matching broken")
+	}
+	if !containsSeq(ws, []string{"datei"}, true) || containsSeq(ws, []string{"ateien"}, true) {
+		t.Error("ending matching broken")
+	}
+	if !containsSeq(ws, []string{"dateien", "speicher"}, true) || containsSeq(ws, []string{"die", "speichern"}, true) {
+		t.Error("phrase matching broken")
+	}
+}
@@ -0,0 +1,128 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/catexport"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	guard "github.com/bodrovis/lokalise-glossary-guard/pkg/validator"
+)
+
+var (
+	file        string
+	langs       []string
+	format      string
+	sourceLang  string
+	output      string
+	onDuplicate string
+)
+
+var exportCmd = &cobra.Command{
+	Use:         "export",
+	Annotations: map[string]string{config.Annotation: "true"},
+	Short:       "Validate a glossary and export it as a term base for CAT tools",
+	Long: `Validate a glossary CSV and, when it passes, convert it to a term base that
+CAT tools import: memoQ CSV, Trados MultiTerm CSV or TBX-Basic.
+
+The term column is written as the --source-lang language; every other
+language column becomes a target language. Language codes follow the
+upload.locale-map of the config, like upload does.
+
+Examples:
+  glossary-guard export -f glossary.csv --format tbx -o glossary.tbx
+  glossary-guard export -f glossary.csv --format memoq --source-lang en_US -o memoq.csv
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if !slices.Contains(catexport.Formats, format) {
+			return fmt.Errorf("invalid --format %q (expected %s)", format, strings.Join(catexport.Formats, ", "))
+		}
+		switch onDuplicate {
+		case lokalise.KeepFirst, lokalise.KeepLast, lokalise.FailDuplicate:
+		default:
+			return fmt.Errorf("invalid --on-duplicate %q (expected %s, %s or %s)",
+				onDuplicate, lokalise.KeepFirst, lokalise.KeepLast, lokalise.FailDuplicate)
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		rep, _, err := guard.ValidateAndFix(cmd.Context(), guard.Source{Path: file, Data: data, Langs: splitLangs(langs)}, checks.RunOptions{})
+		if err != nil {
+			return err
+		}
+		if !rep.OK() {
+			for _, o := range rep.Outcomes {
+				if o.Result.Status == checks.Fail || o.Result.Status == checks.Error {
+					fmt.Fprintf(os.Stderr, "%s: %s\n", o.Result.Name, strings.TrimSpace(o.Result.Message))
+				}
+			}
+			return fmt.Errorf("%s failed validation; run validate for details", file)
+		}
+
+		tbl, err := csvutil.Parse(data)
+		if err != nil {
+			return err
+		}
+		localeMap := config.Get().Upload.LocaleMap
+		terms, locales, dups, err := lokalise.TermsFromTable(tbl, localeMap, onDuplicate)
+		if err != nil {
+			return err
+		}
+		for _, d := range dups {
+			fmt.Fprintf(os.Stderr, "duplicate term %q on lines %v (kept %s)\n", d.Term, d.Lines, onDuplicate)
+		}
+		tb := catexport.New(lokalise.LokaliseCode(sourceLang, localeMap), terms, locales)
+
+		if output == "" {
+			return catexport.Write(os.Stdout, format, tb)
+		}
+		var b strings.Builder
+		if err := catexport.Write(&b, format, tb); err != nil {
+			return err
+		}
+		if err := fsutil.WriteFile(output, []byte(b.String()), 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d term(s) to %s: source %s, targets %s\n",
+			len(tb.Terms), output, tb.Source, strings.Join(tb.Targets, ", "))
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	exportCmd.Flags().StringVarP(&file, "file", "f", "", "Glossary CSV file to export")
+	exportCmd.Flags().StringSliceVarP(&langs, "langs", "l", nil, "Expected language codes (same as validate --langs)")
+	exportCmd.Flags().StringVar(&format, "format", "", "Term base format: "+strings.Join(catexport.Formats, ", "))
+	exportCmd.Flags().StringVar(&sourceLang, "source-lang", "en", "Language of the term column")
+	exportCmd.Flags().StringVarP(&output, "output", "o", "", "Write the term base to this file instead of stdout")
+	exportCmd.Flags().StringVar(&onDuplicate, "on-duplicate", lokalise.KeepFirst, "What to do with terms repeated in the file: first, last or fail")
+
+	root.AddCommand(exportCmd)
+}
+
+func splitLangs(in []string) []string {
+	var out []string
+	for _, l := range in {
+		for _, p := range strings.Split(l, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
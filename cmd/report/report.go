@@ -0,0 +1,89 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	jsonreport "github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+var (
+	jsonOut      bool
+	withWarnings bool
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Work with saved validate --json reports",
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare <base.json> <head.json>",
+	Short: "Show failures introduced or resolved between two JSON reports",
+	Long: `Compare two reports saved with "validate --json" (e.g. from the main branch and
+from a pull request) and list newly introduced failures, resolved failures and
+unchanged ones. Issues are matched by file path and check name.
+
+Exits non-zero when the head report introduces failures, so it can gate CI.
+
+Examples:
+  glossary-guard report compare main.json pr.json
+  glossary-guard report compare main.json pr.json --warnings --json
+`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		base, err := jsonreport.Read(args[0])
+		if err != nil {
+			return err
+		}
+		head, err := jsonreport.Read(args[1])
+		if err != nil {
+			return err
+		}
+
+		d := jsonreport.Compare(base, head, withWarnings)
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		} else {
+			printDiff(os.Stdout, d)
+		}
+
+		if n := len(d.Introduced); n > 0 {
+			return fmt.Errorf("%d new failure(s)", n)
+		}
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	compareCmd.Flags().BoolVar(&jsonOut, "json", false, "Output the comparison as JSON (machine-readable)")
+	compareCmd.Flags().BoolVar(&withWarnings, "warnings", false, "Treat warnings as failures too")
+
+	reportCmd.AddCommand(compareCmd)
+	root.AddCommand(reportCmd)
+}
+
+func printDiff(w io.Writer, d jsonreport.Diff) {
+	section := func(title, mark string, is []jsonreport.Issue) {
+		fmt.Fprintf(w, "%s (%d):\n", title, len(is))
+		for _, i := range is {
+			fmt.Fprintf(w, "  %s %s: %s [%s]", mark, i.File, i.Check, i.Status)
+			if i.Message != "" {
+				fmt.Fprintf(w, " %s", i.Message)
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	section("Introduced", "+", d.Introduced)
+	section("Resolved", "-", d.Resolved)
+	section("Unchanged", "=", d.Unchanged)
+	fmt.Fprintf(w, "\n%d introduced, %d resolved, %d unchanged\n", len(d.Introduced), len(d.Resolved), len(d.Unchanged))
+}
@@ -0,0 +1,25 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	jsonreport "github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+func TestPrintDiff(t *testing.T) {
+	var buf bytes.Buffer
+	printDiff(&buf, jsonreport.Diff{
+		Introduced: []jsonreport.Issue{{File: "a.csv", Check: "c1", Status: "FAIL", Message: "bad"}},
+		Unchanged:  []jsonreport.Issue{{File: "b.csv", Check: "c2", Status: "ERROR"}},
+	})
+	want := "Introduced (1):\n" +
+		"  + a.csv: c1 [FAIL] bad\n" +
+		"Resolved (0):\n" +
+		"Unchanged (1):\n" +
+		"  = b.csv: c2 [ERROR]\n" +
+		"\n1 introduced, 0 resolved, 1 unchanged\n"
+	if buf.String() != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
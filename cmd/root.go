@@ -1,15 +1,30 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/audit"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/conflicts"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/enforce"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/export"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/report"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/trend"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/undo"
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/upload"
 	"github.com/bodrovis/lokalise-glossary-guard/cmd/validate"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/policy"
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 )
 
-var version = "dev"
+var (
+	version    = "dev"
+	configPath string
+	policySrc  policy.Source
+)
 
 func RootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{
@@ -22,9 +37,51 @@ and Y/N flags catching the most common issues (wrong delimiter, missing term/des
 		SilenceUsage:     true,
 		SilenceErrors:    true,
 		TraverseChildren: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Annotations[config.Annotation] == "" {
+				return nil
+			}
+			cfg, err := config.Load(configPath)
+			if err != nil {
+				return err
+			}
+			if policySrc.SHA256 != "" && policySrc.URL == "" {
+				return fmt.Errorf("--policy-sha256 requires --policy")
+			}
+			src := cfg.Policy
+			if policySrc.URL != "" {
+				src = policySrc
+			}
+			if src.URL != "" {
+				b, err := policy.Fetch(cmd.Context(), src, policy.DefaultCacheDir(), func(msg string) {
+					fmt.Fprintln(os.Stderr, "warning:", msg)
+				})
+				if err != nil {
+					return err
+				}
+				if err := cfg.Overlay(b.Config()); err != nil {
+					return err
+				}
+			}
+			config.Set(cfg)
+			return nil
+		},
 	}
 
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Path to config file (default ./"+config.DefaultFile+" if present)")
+	rootCmd.PersistentFlags().StringVar(&policySrc.URL, "policy", "", "Shared policy bundle: URL of a .tar.gz (or policy.yaml), or git+<repo>#<ref>; overrides policy.url of the config")
+	rootCmd.PersistentFlags().StringVar(&policySrc.SHA256, "policy-sha256", "", "Expected sha256 of the --policy archive; a matching cached copy is used offline")
+
+	validate.ToolVersion = version
 	validate.Init(rootCmd)
+	conflicts.Init(rootCmd)
+	report.Init(rootCmd)
+	trend.Init(rootCmd)
+	upload.Init(rootCmd)
+	export.Init(rootCmd)
+	audit.Init(rootCmd)
+	enforce.Init(rootCmd)
+	undo.Init(rootCmd)
 
 	rootCmd.AddCommand(&cobra.Command{
 		Use:   "version",
@@ -47,6 +104,15 @@ and Y/N flags catching the most common issues (wrong delimiter, missing term/des
 	return rootCmd
 }
 
+// ExitCode is the process exit status for an error returned by the root
+// command: validate.ExitFixed for validate.ErrFixed, 1 otherwise.
+func ExitCode(err error) int {
+	if errors.Is(err, validate.ErrFixed) {
+		return validate.ExitFixed
+	}
+	return 1
+}
+
 func generateDocs(rootCmd *cobra.Command, dir string) error {
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return err
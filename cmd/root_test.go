@@ -1,11 +1,22 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/cmd/validate"
 )
 
+// testRoot builds the root command once: subcommands are package globals
+// and cannot be registered twice.
+var testRoot = sync.OnceValue(RootCmd)
+
 func TestRootCmd_HasValidate(t *testing.T) {
-	cmd := RootCmd()
+	cmd := testRoot()
 	found := false
 	for _, c := range cmd.Commands() {
 		if c.Name() == "validate" {
@@ -16,3 +27,35 @@ func TestRootCmd_HasValidate(t *testing.T) {
 		t.Fatal("validate subcommand not registered")
 	}
 }
+
+func TestExitCode(t *testing.T) {
+	if got := ExitCode(fmt.Errorf("run: %w", validate.ErrFixed)); got != validate.ExitFixed {
+		t.Fatalf("ExitCode(ErrFixed) = %d, want %d", got, validate.ExitFixed)
+	}
+	if got := ExitCode(errors.New("validation failed")); got != 1 {
+		t.Fatalf("ExitCode(other) = %d, want 1", got)
+	}
+}
+
+func TestOfflineCommandsSkipPolicy(t *testing.T) {
+	const unreachable = "https://127.0.0.1:1/p.tar.gz"
+	t.Chdir(t.TempDir())
+	root := testRoot()
+	root.SetOut(io.Discard)
+	for _, args := range [][]string{
+		{"version"},
+		{"completion", "bash"},
+		{"undo-fix"},
+	} {
+		root.SetArgs(append([]string{"--policy", unreachable}, args...))
+		err := root.Execute()
+		if err != nil && strings.Contains(err.Error(), "policy") {
+			t.Errorf("%v fetched the policy: %v", args, err)
+		}
+	}
+
+	root.SetArgs([]string{"--policy", unreachable, "validate", "-f", "g.csv"})
+	if err := root.Execute(); err == nil || !strings.Contains(err.Error(), "policy") {
+		t.Errorf("validate should fetch the policy, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/ledger"
+)
+
+var (
+	last             int
+	jsonOut          bool
+	failOnRegression bool
+)
+
+type result struct {
+	Direction ledger.Direction `json:"direction"`
+	Runs      []ledger.Entry   `json:"runs"`
+}
+
+var trendCmd = &cobra.Command{
+	Use:   "trend [ledger.jsonl]",
+	Short: "Report whether glossary health is improving or regressing",
+	Long: `Read a ledger written by "validate --ledger" and compare the oldest and newest
+runs in the window: fewer failing files means improving, more means regressing;
+with the same number of failures, warnings decide.
+
+Examples:
+  glossary-guard validate -f glossary.csv --ledger ` + ledger.DefaultFile + `
+  glossary-guard trend
+  glossary-guard trend history/ledger.jsonl --last 30 --fail-on-regression
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := ledger.DefaultFile
+		if len(args) == 1 {
+			path = args[0]
+		}
+		entries, err := ledger.Read(path)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return fmt.Errorf("%s: ledger is empty", path)
+		}
+		if last > 0 && len(entries) > last {
+			entries = entries[len(entries)-last:]
+		}
+
+		res := result{Direction: ledger.Trend(entries), Runs: entries}
+		if jsonOut {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(res); err != nil {
+				return err
+			}
+		} else {
+			printTrend(os.Stdout, res)
+		}
+
+		if failOnRegression && res.Direction == ledger.Regressing {
+			return fmt.Errorf("glossary health is regressing")
+		}
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	trendCmd.Flags().IntVar(&last, "last", 10, "Only consider the most recent N runs (0 = all)")
+	trendCmd.Flags().BoolVar(&jsonOut, "json", false, "Output the trend as JSON (machine-readable)")
+	trendCmd.Flags().BoolVar(&failOnRegression, "fail-on-regression", false, "Exit non-zero when the trend is regressing")
+
+	root.AddCommand(trendCmd)
+}
+
+func printTrend(w io.Writer, res result) {
+	for _, e := range res.Runs {
+		commit := e.Commit
+		if len(commit) > 12 {
+			commit = commit[:12]
+		}
+		if commit == "" {
+			commit = "-"
+		}
+		fmt.Fprintf(w, "%s  %-12s  files=%d pass=%d warn=%d fail=%d error=%d\n",
+			e.Time.Format(time.RFC3339), commit, e.Files, e.Pass, e.Warn, e.Fail, e.Error)
+	}
+	first, lastRun := res.Runs[0], res.Runs[len(res.Runs)-1]
+	fmt.Fprintf(w, "\nTrend over %d run(s): %s (failing files %d -> %d, warnings %d -> %d)\n",
+		len(res.Runs), res.Direction, first.Failing(), lastRun.Failing(), first.Warn, lastRun.Warn)
+}
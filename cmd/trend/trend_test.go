@@ -0,0 +1,27 @@
+package trend
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/ledger"
+)
+
+func TestPrintTrend(t *testing.T) {
+	ts := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	printTrend(&buf, result{
+		Direction: ledger.Improving,
+		Runs: []ledger.Entry{
+			{Time: ts, Commit: "0123456789abcdef", Files: 2, Pass: 1, Fail: 1},
+			{Time: ts.Add(time.Hour), Files: 2, Pass: 1, Warn: 1},
+		},
+	})
+	want := "2025-11-03T10:00:00Z  0123456789ab  files=2 pass=1 warn=0 fail=1 error=0\n" +
+		"2025-11-03T11:00:00Z  -             files=2 pass=1 warn=1 fail=0 error=0\n" +
+		"\nTrend over 2 run(s): improving (failing files 1 -> 0, warnings 0 -> 1)\n"
+	if buf.String() != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
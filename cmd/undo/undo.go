@@ -0,0 +1,79 @@
+package undo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/undo"
+)
+
+var (
+	dir   string
+	force bool
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo-fix",
+	Short: "Revert the files written by the last validate --fix run",
+	Long: `Restore every file the most recent "validate --fix" run wrote: fixed copies
+are removed (or get their previous content back) and files fixed with --in-place
+get their original content back.
+
+A file edited since the fix run is left alone unless --force is given. Restored
+files are dropped from the journal, so running undo-fix again only retries the
+ones that were left.
+
+Examples:
+  glossary-guard validate -f glossary.csv --fix --in-place
+  glossary-guard undo-fix
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return run(os.Stdout)
+	},
+}
+
+func run(w io.Writer) error {
+	j, err := undo.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	var left []undo.Entry
+	for _, e := range j.Entries {
+		err := e.Restore(force)
+		switch {
+		case err == nil && e.Before == "":
+			fmt.Fprintf(w, "removed %s\n", e.Path)
+		case err == nil:
+			fmt.Fprintf(w, "restored %s\n", e.Path)
+		case errors.Is(err, undo.ErrChanged):
+			fmt.Fprintf(w, "skipped %s: %v (use --force to restore it anyway)\n", e.Path, err)
+			left = append(left, e)
+		default:
+			fmt.Fprintf(w, "failed %s: %v\n", e.Path, err)
+			left = append(left, e)
+		}
+	}
+
+	if len(left) == 0 {
+		return undo.Clear(dir)
+	}
+	total := len(j.Entries)
+	j.Entries = left
+	if err := undo.Save(dir, j); err != nil {
+		return err
+	}
+	return fmt.Errorf("%d of %d file(s) were not restored", len(left), total)
+}
+
+func Init(root *cobra.Command) {
+	undoCmd.Flags().StringVar(&dir, "undo-dir", undo.DefaultDir, "Journal directory written by validate --fix")
+	undoCmd.Flags().BoolVar(&force, "force", false, "Restore files even if they changed after the fix run")
+
+	root.AddCommand(undoCmd)
+}
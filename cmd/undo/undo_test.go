@@ -0,0 +1,55 @@
+package undo
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/undo"
+)
+
+func TestRun(t *testing.T) {
+	tmp := t.TempDir()
+	dir = filepath.Join(tmp, undo.DefaultDir)
+	t.Cleanup(func() { dir, force = undo.DefaultDir, false })
+
+	kept := filepath.Join(tmp, "a_fixed.csv")
+	edited := filepath.Join(tmp, "b_fixed.csv")
+	r := undo.Begin(dir)
+	for _, p := range []string{kept, edited} {
+		if err := r.Record(p, []byte("fixed")); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("fixed"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(edited, []byte("edited"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err := run(&buf)
+	if err == nil || err.Error() != "1 of 2 file(s) were not restored" {
+		t.Fatalf("err = %v", err)
+	}
+	if !strings.Contains(buf.String(), "removed "+kept) || !strings.Contains(buf.String(), "skipped "+edited) {
+		t.Fatalf("output:\n%s", buf.String())
+	}
+
+	// The skipped file stays in the journal for a forced retry.
+	force = true
+	buf.Reset()
+	if err := run(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "removed "+edited+"\n" {
+		t.Fatalf("output:\n%s", buf.String())
+	}
+	if _, err := undo.Load(dir); !errors.Is(err, undo.ErrNoJournal) {
+		t.Fatalf("journal left: %v", err)
+	}
+}
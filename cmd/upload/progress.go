@@ -0,0 +1,46 @@
+package upload
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+)
+
+const barWidth = 30
+
+// progress draws a bar that is redrawn in place on a terminal; elsewhere
+// (CI logs) it prints one line per chunk.
+type progress struct {
+	w     io.Writer
+	tty   bool
+	terms int
+}
+
+func newProgress(w *os.File, terms int) *progress {
+	fi, err := w.Stat()
+	return &progress{w: w, tty: err == nil && fi.Mode()&os.ModeCharDevice != 0, terms: terms}
+}
+
+func (p *progress) update(chunk, total int, res lokalise.Result) {
+	sent := res.Created + res.Updated + res.Skipped
+	if !p.tty {
+		fmt.Fprintf(p.w, "chunk %d/%d done (%d/%d terms)\n", chunk+1, total, sent, p.terms)
+		return
+	}
+	filled := barWidth * (chunk + 1) / total
+	fmt.Fprintf(p.w, "\r[%s%s] %d/%d chunks, %d/%d terms",
+		strings.Repeat("#", filled), strings.Repeat(" ", barWidth-filled), chunk+1, total, sent, p.terms)
+	if chunk+1 == total {
+		fmt.Fprintln(p.w)
+	}
+}
+
+// abort ends an unfinished bar so the error starts on its own line.
+func (p *progress) abort() {
+	if p.tty {
+		fmt.Fprintln(p.w)
+	}
+}
@@ -0,0 +1,209 @@
+package upload
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	guard "github.com/bodrovis/lokalise-glossary-guard/pkg/validator"
+)
+
+// TokenEnv is read when --token is not given.
+const TokenEnv = lokalise.TokenEnv
+
+var (
+	file        string
+	langs       []string
+	projectID   string
+	token       string
+	dryRun      bool
+	onDuplicate string
+	batchSize   int
+	retries     int
+	resumeFile  string
+	verify      bool
+)
+
+var uploadCmd = &cobra.Command{
+	Use:         "upload",
+	Annotations: map[string]string{config.Annotation: "true"},
+	Short:       "Validate a glossary and upload its terms to a Lokalise project",
+	Long: `Validate a glossary CSV and, when it passes, push its terms to the glossary of a
+Lokalise project. Terms that already exist (exact match) are updated, others
+are created.
+
+Use --dry-run to see the locale mapping, duplicate handling and the exact API
+calls with their batch sizes without contacting Lokalise.
+
+Terms are sent in chunks of --batch-size. A chunk whose request fails with a
+network error, 429 or 5xx is retried (--retries) with exponential backoff.
+Finished chunks are recorded in a resume file; if the upload stops, running
+the same command again skips them. The file is removed once all chunks are
+uploaded, and ignored when the glossary or the chunking changed.
+
+With --verify the project glossary is downloaded again after the upload and
+compared with the file; terms Lokalise rejected or stored differently are
+listed and the command fails.
+
+The API token is read from --token or the ` + TokenEnv + ` environment variable.
+
+Examples:
+  glossary-guard upload -f glossary.csv --project-id 123abc.456 --dry-run
+  glossary-guard upload -f glossary.csv -l en -l de_DE --project-id 123abc.456
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		cfg := config.Get().Upload
+		if projectID == "" {
+			projectID = cfg.ProjectID
+		}
+		if projectID == "" {
+			return fmt.Errorf("--project-id is required (or set upload.project-id in the config)")
+		}
+		switch onDuplicate {
+		case lokalise.KeepFirst, lokalise.KeepLast, lokalise.FailDuplicate:
+		default:
+			return fmt.Errorf("invalid --on-duplicate %q (expected %s, %s or %s)",
+				onDuplicate, lokalise.KeepFirst, lokalise.KeepLast, lokalise.FailDuplicate)
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		rep, _, err := guard.ValidateAndFix(cmd.Context(), guard.Source{Path: file, Data: data, Langs: splitLangs(langs)}, checks.RunOptions{})
+		if err != nil {
+			return err
+		}
+		if !rep.OK() {
+			for _, o := range rep.Outcomes {
+				if o.Result.Status == checks.Fail || o.Result.Status == checks.Error {
+					fmt.Fprintf(os.Stderr, "%s: %s\n", o.Result.Name, strings.TrimSpace(o.Result.Message))
+				}
+			}
+			return fmt.Errorf("%s failed validation; run validate for details", file)
+		}
+
+		tbl, err := csvutil.Parse(data)
+		if err != nil {
+			return err
+		}
+		terms, locales, dups, err := lokalise.TermsFromTable(tbl, cfg.LocaleMap, onDuplicate)
+		if err != nil {
+			return err
+		}
+		plan := lokalise.Plan{
+			Project:    projectID,
+			Terms:      terms,
+			Locales:    locales,
+			Duplicates: dups,
+			Policy:     onDuplicate,
+			BatchSize:  batchSize,
+		}
+
+		if dryRun {
+			return plan.WriteDryRun(os.Stdout)
+		}
+
+		if token == "" {
+			token = os.Getenv(TokenEnv)
+		}
+		if token == "" {
+			return fmt.Errorf("no API token: pass --token or set %s", TokenEnv)
+		}
+		if resumeFile == "" {
+			resumeFile = lokalise.DefaultResumePath(file)
+		}
+		state, stale, err := lokalise.LoadResume(resumeFile, plan)
+		if err != nil {
+			return err
+		}
+		if stale {
+			fmt.Fprintf(os.Stderr, "Ignoring %s: it was written for a different glossary, project or batch size\n", resumeFile)
+		} else if len(state.Done) > 0 {
+			fmt.Fprintf(os.Stderr, "Resuming from %s: %s\n", resumeFile, state)
+		}
+
+		bar := newProgress(os.Stderr, len(plan.Terms))
+		res, err := lokalise.Upload(cmd.Context(), lokalise.NewClient(token), plan, lokalise.UploadOptions{
+			Retries: retries,
+			Backoff: time.Second,
+			Done:    state.DoneSet(),
+			OnChunk: func(chunk, total int, res lokalise.Result) error {
+				state.MarkDone(chunk)
+				bar.update(chunk, total, res)
+				return state.Save(resumeFile)
+			},
+		})
+		if err != nil {
+			bar.abort()
+			if len(state.Done) > 0 {
+				fmt.Fprintf(os.Stderr, "Progress saved to %s (%s); rerun the same command to resume\n", resumeFile, state)
+			}
+			return err
+		}
+		if err := os.Remove(resumeFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "could not remove %s: %v\n", resumeFile, err)
+		}
+		fmt.Printf("Uploaded %d term(s) to project %s: %d created, %d updated",
+			res.Created+res.Updated, projectID, res.Created, res.Updated)
+		if res.Skipped > 0 {
+			fmt.Printf(", %d already sent by an earlier run", res.Skipped)
+		}
+		fmt.Println()
+
+		if !verify {
+			return nil
+		}
+		v, err := lokalise.Verify(cmd.Context(), lokalise.NewClient(token), plan)
+		if err != nil {
+			return fmt.Errorf("verify: %w", err)
+		}
+		lokalise.WriteVerification(os.Stdout, v)
+		if !v.OK() {
+			return fmt.Errorf("verification failed: %d term(s) missing, %d field(s) changed in project %s",
+				len(v.Missing), len(v.Changed), projectID)
+		}
+		return nil
+	},
+}
+
+func Init(root *cobra.Command) {
+	uploadCmd.Flags().StringVarP(&file, "file", "f", "", "Glossary CSV file to upload")
+	uploadCmd.Flags().StringSliceVarP(&langs, "langs", "l", nil, "Expected language codes (same as validate --langs)")
+	uploadCmd.Flags().StringVar(&projectID, "project-id", "", "Lokalise project ID (default: upload.project-id from the config)")
+	uploadCmd.Flags().StringVar(&token, "token", "", "Lokalise API token (default: $"+TokenEnv+")")
+	uploadCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the mapping and the API calls that would be made, without contacting Lokalise")
+	uploadCmd.Flags().StringVar(&onDuplicate, "on-duplicate", lokalise.KeepFirst, "What to do with terms repeated in the file: first, last or fail")
+	uploadCmd.Flags().IntVar(&batchSize, "batch-size", lokalise.MaxTermsPerRequest, "Terms per API request (max 1000)")
+	uploadCmd.Flags().IntVar(&retries, "retries", 3, "Retries per chunk on network errors, 429 and 5xx responses")
+	uploadCmd.Flags().BoolVar(&verify, "verify", false, "Download the project glossary after the upload and report terms that were rejected or modified")
+	uploadCmd.Flags().StringVar(&resumeFile, "resume-file", "", "Where to record finished chunks (default <file>.upload-state.json)")
+
+	root.AddCommand(uploadCmd)
+}
+
+func splitLangs(in []string) []string {
+	var out []string
+	for _, l := range in {
+		for _, p := range strings.Split(l, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
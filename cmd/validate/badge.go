@@ -0,0 +1,48 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+)
+
+// badge is a shields.io endpoint document:
+// https://shields.io/badges/endpoint-badge
+type badge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// buildBadge counts files that failed or could not be validated; warnings
+// keep the badge "passing" but turn it yellow.
+func buildBadge(outcomes []fileOutcome) badge {
+	b := badge{SchemaVersion: 1, Label: "glossary", Message: "passing", Color: "brightgreen"}
+	failing, warned := 0, 0
+	for _, oc := range outcomes {
+		switch _, class := fileResult(oc); class {
+		case "fail", "error":
+			failing++
+		case "warn":
+			warned++
+		}
+	}
+	switch {
+	case failing > 0:
+		b.Message = fmt.Sprintf("%d failing", failing)
+		b.Color = "red"
+	case warned > 0:
+		b.Color = "yellow"
+	}
+	return b
+}
+
+func writeBadge(path string, outcomes []fileOutcome) error {
+	data, err := json.Marshal(buildBadge(outcomes))
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFile(path, append(data, '\n'), 0o644)
+}
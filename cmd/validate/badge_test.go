@@ -0,0 +1,25 @@
+package validate
+
+import "testing"
+
+func TestBuildBadge(t *testing.T) {
+	cases := []struct {
+		name     string
+		outcomes []fileOutcome
+		msg      string
+		color    string
+	}{
+		{"all pass", []fileOutcome{{Passed: 1}, {Passed: 1}}, "passing", "brightgreen"},
+		{"warnings", []fileOutcome{{Passed: 1}, {Warned: 1}}, "passing", "yellow"},
+		{"failing", []fileOutcome{{Failed: 1}, {HadOpErr: true}, {Warned: 1}}, "2 failing", "red"},
+	}
+	for _, tc := range cases {
+		b := buildBadge(tc.outcomes)
+		if b.SchemaVersion != 1 || b.Label != "glossary" {
+			t.Errorf("%s: unexpected header %+v", tc.name, b)
+		}
+		if b.Message != tc.msg || b.Color != tc.color {
+			t.Errorf("%s: got %q/%q, want %q/%q", tc.name, b.Message, b.Color, tc.msg, tc.color)
+		}
+	}
+}
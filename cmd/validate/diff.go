@@ -0,0 +1,242 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// maxDiffLines caps the diff printed for one fixed file; the fixed file
+// itself has everything.
+const maxDiffLines = 200
+
+// maxDiffEdits bounds the work spent on a diff. Fixes that rewrite more
+// lines than this (e.g. reordering every column) are summarized instead.
+const maxDiffEdits = 2000
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// writeFixDiff prints a unified, line-level diff from the original to the
+// fixed content with context unchanged lines around each change. Lines are
+// compared without BOM and line-ending differences; when those are all
+// that changed, a single note says so.
+func writeFixDiff(w io.Writer, oldName, newName string, oldData, newData []byte, context int) {
+	ops, ok := diffLines(splitLines(oldData), splitLines(newData))
+	if !ok {
+		fmt.Fprintf(w, "   %s too many changed lines to show a diff\n", cyan("diff:"))
+		return
+	}
+	hunks := diffHunks(ops, context)
+	if len(hunks) == 0 {
+		fmt.Fprintf(w, "   %s only the BOM, line endings or final newline changed\n", cyan("diff:"))
+		return
+	}
+
+	fmt.Fprintln(w, red("--- "+oldName))
+	fmt.Fprintln(w, green("+++ "+newName))
+	printed := 0
+	for _, h := range hunks {
+		if printed >= maxDiffLines {
+			fmt.Fprintf(w, "... diff truncated, see %s\n", newName)
+			return
+		}
+		fmt.Fprintln(w, cyan(h.header()))
+		printed++
+		for _, op := range ops[h.start:h.end] {
+			if printed >= maxDiffLines {
+				fmt.Fprintf(w, "... diff truncated, see %s\n", newName)
+				return
+			}
+			line := string(op.kind) + op.line
+			switch op.kind {
+			case '-':
+				line = red(line)
+			case '+':
+				line = green(line)
+			}
+			fmt.Fprintln(w, line)
+			printed++
+		}
+	}
+}
+
+// fixSummary counts what the fixes of a run changed: the checks that
+// changed the file and the lines removed and added, for --fix-dry-run.
+func fixSummary(sum validator.Summary, oldData, newData []byte) string {
+	n := 0
+	for _, o := range sum.Outcomes {
+		if o.Final.DidChange {
+			n++
+		}
+	}
+	out := fmt.Sprintf("%d check(s) would change it", n)
+	ops, ok := diffLines(splitLines(oldData), splitLines(newData))
+	if !ok {
+		return out + ", too many changed lines to count"
+	}
+	var removed, added int
+	for _, op := range ops {
+		switch op.kind {
+		case '-':
+			removed++
+		case '+':
+			added++
+		}
+	}
+	return fmt.Sprintf("%s, %d line(s) removed, %d added", out, removed, added)
+}
+
+// splitLines splits data into lines without BOM, "\r" or a trailing empty
+// line.
+func splitLines(data []byte) []string {
+	s := strings.TrimPrefix(string(data), "\ufeff")
+	s = strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// diffLines returns the shortest edit script from a to b (Myers, "An O(ND)
+// Difference Algorithm"). ok is false when it needs more than maxDiffEdits
+// edits.
+func diffLines(a, b []string) (ops []diffOp, ok bool) {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxDiffEdits)
+	off := limit + 1
+	v := make([]int, 2*limit+3)
+	// trace[d] holds v[-d..d] after step d, for the backtrack.
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				trace = append(trace, append([]int(nil), v[off-d:off+d+1]...))
+				return backtrack(a, b, trace), true
+			}
+		}
+		trace = append(trace, append([]int(nil), v[off-d:off+d+1]...))
+	}
+	return nil, false
+}
+
+func backtrack(a, b []string, trace [][]int) []diffOp {
+	x, y := len(a), len(b)
+	var rev []diffOp
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1] // v[-(d-1)..d-1]
+		at := func(k int) int { return prev[k+d-1] }
+		k := x - y
+		var pk int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			pk = k + 1
+		} else {
+			pk = k - 1
+		}
+		px := at(pk)
+		py := px - pk
+		for x > px && y > py {
+			x--
+			y--
+			rev = append(rev, diffOp{' ', a[x]})
+		}
+		if x == px {
+			y--
+			rev = append(rev, diffOp{'+', b[y]})
+		} else {
+			x--
+			rev = append(rev, diffOp{'-', a[x]})
+		}
+	}
+	for x > 0 {
+		x--
+		rev = append(rev, diffOp{' ', a[x]})
+	}
+
+	ops := make([]diffOp, len(rev))
+	for i, op := range rev {
+		ops[len(rev)-1-i] = op
+	}
+	return ops
+}
+
+// diffHunk is a range of ops printed together, with the 1-based line
+// numbers it starts at in both files.
+type diffHunk struct {
+	start, end       int
+	oldLine, newLine int
+	oldLen, newLen   int
+}
+
+func (h diffHunk) header() string {
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.oldLine, h.oldLen, h.newLine, h.newLen)
+}
+
+// diffHunks groups changes that are at most 2*context unchanged lines apart.
+func diffHunks(ops []diffOp, context int) []diffHunk {
+	var hunks []diffHunk
+	oldLine, newLine := make([]int, len(ops)+1), make([]int, len(ops)+1)
+	for i, op := range ops {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if op.kind != '+' {
+			oldLine[i+1]++
+		}
+		if op.kind != '-' {
+			newLine[i+1]++
+		}
+	}
+
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := max(i-context, 0)
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				end = min(end+context, len(ops))
+				break
+			}
+			end = next
+		}
+		h := diffHunk{start: start, end: end}
+		h.oldLen = oldLine[end] - oldLine[start]
+		h.newLen = newLine[end] - newLine[start]
+		h.oldLine, h.newLine = oldLine[start]+1, newLine[start]+1
+		// Unified diff numbers an empty range by the line before it.
+		if h.oldLen == 0 {
+			h.oldLine--
+		}
+		if h.newLen == 0 {
+			h.newLine--
+		}
+		hunks = append(hunks, h)
+		i = end
+	}
+	return hunks
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestWriteFixDiff(t *testing.T) {
+	noColor = true
+	t.Cleanup(func() { noColor = false })
+
+	old := "term;description\nAPI;one\n\nSDK;two\na;1\nb;2\nc;3\nd;4\ne;5\nf;6\nlast;x\n"
+	fixed := "term;description\nAPI;one\nSDK;two\na;1\nb;2\nc;3\nd;4\ne;5\nf;6\nlast;y\n"
+
+	var buf bytes.Buffer
+	writeFixDiff(&buf, "g.csv", "g_fixed.csv", []byte(old), []byte(fixed), 1)
+	want := strings.Join([]string{
+		"--- g.csv",
+		"+++ g_fixed.csv",
+		"@@ -2,3 +2,2 @@",
+		" API;one",
+		"-",
+		" SDK;two",
+		"@@ -10,2 +9,2 @@",
+		" f;6",
+		"-last;x",
+		"+last;y",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Fatalf("diff:\n%s\nwant:\n%s", got, want)
+	}
+
+	buf.Reset()
+	writeFixDiff(&buf, "g.csv", "g_fixed.csv", []byte("\ufeffa;b\r\nc;d"), []byte("a;b\nc;d\n"), 3)
+	if got := buf.String(); !strings.Contains(got, "only the BOM, line endings or final newline changed") {
+		t.Fatalf("line-ending-only diff = %q", got)
+	}
+}
+
+func TestDiffLinesInsertAndDelete(t *testing.T) {
+	ops, ok := diffLines([]string{"a", "b", "c"}, []string{"x", "a", "c", "d"})
+	if !ok {
+		t.Fatal("diffLines gave up")
+	}
+	var got []string
+	for _, op := range ops {
+		got = append(got, string(op.kind)+op.line)
+	}
+	if want := "+x, a,-b, c,+d"; strings.Join(got, ",") != want {
+		t.Fatalf("ops = %q, want %q", strings.Join(got, ","), want)
+	}
+}
+
+func TestFixSummary(t *testing.T) {
+	sum := validator.Summary{Outcomes: []checks.CheckOutcome{
+		{Final: checks.FixResult{DidChange: true}},
+		{Final: checks.FixResult{}},
+	}}
+	got := fixSummary(sum, []byte("a;1\nb;2\nb;2\n"), []byte("a;1\nb;3\n"))
+	if want := "1 check(s) would change it, 2 line(s) removed, 1 added"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
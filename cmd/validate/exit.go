@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+// ExitFixed is the exit status of validate --fail-on-fix when every file
+// passes after fixing but some were modified, or would be with
+// --fix-dry-run. CI can then ask for a local --fix run instead of reporting
+// a validation failure.
+const ExitFixed = 3
+
+// ErrFixed is returned by validate --fail-on-fix in that case.
+var ErrFixed = errors.New("files were modified by fixes; run validate --fix locally and commit the result")
+
+var failOnFix bool
+
+// runResult is the overall result of a run, as written to the JSON report.
+// Errors win over failures, and failures over fixes.
+func runResult(outcomes []fileOutcome) string {
+	var hadOpErr, hadValFail, modified bool
+	for _, oc := range outcomes {
+		hadOpErr = hadOpErr || oc.HadOpErr
+		hadValFail = hadValFail || oc.HadValFail
+		modified = modified || oc.Modified
+	}
+	switch {
+	case hadOpErr:
+		return report.ResultError
+	case hadValFail:
+		return report.ResultFailed
+	case modified:
+		return report.ResultFixed
+	}
+	return report.ResultPassed
+}
+
+func aggregateReturnCode(outcomes []fileOutcome) error {
+	switch runResult(outcomes) {
+	case report.ResultError:
+		return fmt.Errorf("one or more files could not be validated due to an error")
+	case report.ResultFailed:
+		return fmt.Errorf("validation failed")
+	case report.ResultFixed:
+		if failOnFix {
+			return ErrFixed
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+func TestRunResult(t *testing.T) {
+	t.Cleanup(func() { failOnFix = false })
+
+	cases := []struct {
+		outcomes []fileOutcome
+		want     string
+	}{
+		{[]fileOutcome{{Passed: 1}, {Warned: 1}}, report.ResultPassed},
+		{[]fileOutcome{{Passed: 1, Modified: true}, {Passed: 1}}, report.ResultFixed},
+		{[]fileOutcome{{Passed: 1, Modified: true}, {Failed: 1, HadValFail: true}}, report.ResultFailed},
+		{[]fileOutcome{{Failed: 1, HadValFail: true}, {Errored: 1, HadOpErr: true}}, report.ResultError},
+	}
+	for i, c := range cases {
+		if got := runResult(c.outcomes); got != c.want {
+			t.Errorf("case %d: runResult = %q, want %q", i, got, c.want)
+		}
+	}
+
+	fixed := []fileOutcome{{Passed: 1, Modified: true}}
+	if err := aggregateReturnCode(fixed); err != nil {
+		t.Fatalf("without --fail-on-fix: %v", err)
+	}
+	failOnFix = true
+	if err := aggregateReturnCode(fixed); !errors.Is(err, ErrFixed) {
+		t.Fatalf("with --fail-on-fix: %v, want ErrFixed", err)
+	}
+}
@@ -0,0 +1,143 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExpandFilesDirectories(t *testing.T) {
+	dir := t.TempDir()
+	for _, p := range []string{"a.csv", "b.CSV", "notes.txt", "sub/c.csv", "sub/deep/d.csv", ".git/e.csv"} {
+		p = filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() { recursive = false })
+
+	rel := func(paths []string) string {
+		var out []string
+		for _, p := range paths {
+			r, _ := filepath.Rel(dir, p)
+			out = append(out, filepath.ToSlash(r))
+		}
+		return strings.Join(out, ",")
+	}
+
+	got, err := expandFiles([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a.csv,b.CSV"; rel(got) != want {
+		t.Errorf("flat: got %s, want %s", rel(got), want)
+	}
+
+	recursive = true
+	got, err = expandFiles([]string{dir, filepath.Join(dir, "a.csv")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a.csv,b.CSV,sub/c.csv,sub/deep/d.csv"; rel(got) != want {
+		t.Errorf("recursive: got %s, want %s", rel(got), want)
+	}
+
+	empty := filepath.Join(dir, "empty")
+	if err := os.Mkdir(empty, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := expandFiles([]string{empty}); err == nil {
+		t.Error("a directory without CSV files should match nothing")
+	}
+}
+
+func TestExcludeFiles(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{
+		"g.csv",
+		"g_fixed.csv",
+		"./data/de_fixed.csv",
+		"archive/2023/old.csv",
+		filepath.Join(wd, "archive", "abs.csv"),
+		"live/archive.csv",
+	}
+	got, err := excludeFiles(append([]string(nil), paths...), []string{"**/*_fixed.csv", "archive/**"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "g.csv,live/archive.csv"; strings.Join(got, ",") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+
+	// Without a slash the pattern matches file names anywhere.
+	got, err = excludeFiles([]string{"a/b/g_fixed.csv", "g.csv"}, []string{"*_fixed.csv"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "g.csv" {
+		t.Errorf("got %v", got)
+	}
+
+	if _, err := excludeFiles([]string{"g.csv"}, []string{"*.csv"}); err == nil {
+		t.Error("excluding everything should fail")
+	}
+	if _, err := excludeFiles([]string{"g.csv"}, []string{"["}); err == nil {
+		t.Error("bad pattern accepted")
+	}
+}
+
+func TestExpandFilesIgnoreFile(t *testing.T) {
+	dir := t.TempDir()
+	for p, content := range map[string]string{
+		".glossaryguardignore":     "fixtures/\n",
+		"g.csv":                    "",
+		"fixtures/f.csv":           "",
+		"sub/h.csv":                "",
+		"sub/old.csv":              "",
+		"sub/.glossaryguardignore": "old.csv\n",
+	} {
+		p = filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(dir)
+	recursive = true
+	t.Cleanup(func() { recursive, noIgnore = false, false })
+
+	got, err := expandFiles([]string{"."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "g.csv,sub/h.csv"; strings.Join(got, ",") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+
+	// Files named explicitly run whatever the ignore files say.
+	got, err = expandFiles([]string{"sub/*.csv", "fixtures/f.csv"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "sub/h.csv,fixtures/f.csv"; strings.Join(got, ",") != want {
+		t.Errorf("explicit: got %v, want %s", got, want)
+	}
+
+	noIgnore = true
+	got, err = expandFiles([]string{"."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "fixtures/f.csv,g.csv,sub/h.csv,sub/old.csv"; strings.Join(got, ",") != want {
+		t.Errorf("--no-ignore: got %v, want %s", got, want)
+	}
+}
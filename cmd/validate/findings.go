@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+// finding is one reportable problem: a non-passing check outcome or a
+// file-level operational error.
+type finding struct {
+	File    string
+	Check   string
+	Code    string
+	Status  checks.Status
+	Row     int
+	Column  string
+	Message string
+	// Remediation is the check's "what to do" hint, if it has one.
+	Remediation string
+}
+
+var findingsHeader = []string{"file", "check", "code", "status", "row", "column", "message", "remediation"}
+
+// collectFindings flattens outcomes into findings in file/check order: one
+// per individual finding when the check reported any, else one carrying
+// the check message.
+func collectFindings(outcomes []fileOutcome) []finding {
+	var out []finding
+	for _, oc := range outcomes {
+		if oc.OpError != "" {
+			out = append(out, finding{File: oc.Path, Status: checks.Error, Message: oc.OpError})
+		}
+		if oc.Summary == nil {
+			continue
+		}
+		for i, o := range oc.Summary.Outcomes {
+			if o.Result.Status == checks.Pass {
+				continue
+			}
+			base := finding{
+				File:        oc.Path,
+				Check:       o.Result.Name,
+				Code:        checkmeta.CodeFor(o.Result.Name),
+				Status:      o.Result.Status,
+				Message:     oneLine(strings.TrimSpace(o.Result.Message)),
+				Remediation: checkmeta.RemediationFor(o.Result.Name).String(),
+			}
+			var fds []findings.Finding
+			if i < len(oc.Findings) {
+				fds = oc.Findings[i]
+			}
+			if len(fds) == 0 {
+				out = append(out, base)
+				continue
+			}
+			// one row per finding so spreadsheets can filter by row/column
+			for _, f := range fds {
+				fd := base
+				fd.Row, fd.Column, fd.Status = f.Row, f.Column, f.Severity
+				if f.Message != "" {
+					fd.Message = oneLine(f.Message)
+				}
+				out = append(out, fd)
+			}
+		}
+	}
+	return out
+}
+
+// writeFindings dumps findings as CSV, or TSV when the path ends in .tsv.
+func writeFindings(path string, fs []finding) error {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(f)
+	if strings.EqualFold(filepath.Ext(path), ".tsv") {
+		w.Comma = '\t'
+	}
+	_ = w.Write(findingsHeader)
+	for _, fd := range fs {
+		row := ""
+		if fd.Row > 0 {
+			row = strconv.Itoa(fd.Row)
+		}
+		_ = w.Write([]string{fd.File, fd.Check, fd.Code, string(fd.Status), row, fd.Column, fd.Message, fd.Remediation})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
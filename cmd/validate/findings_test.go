@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestFindingsExport_TSV(t *testing.T) {
+	outcomes := []fileOutcome{
+		{
+			Path: "a.csv",
+			Summary: &validator.Summary{Outcomes: []checks.CheckOutcome{
+				{Result: checks.CheckResult{Name: "ok-check", Status: checks.Pass, Message: "fine"}},
+				{Result: checks.CheckResult{Name: "ensure-not-empty", Status: checks.Fail, Message: "broken\nrow 3"}},
+			}},
+		},
+		{Path: "b.csv", HadOpErr: true, OpError: "open b.csv: no such file"},
+		{
+			Path: "c.csv",
+			Summary: &validator.Summary{Outcomes: []checks.CheckOutcome{
+				{Result: checks.CheckResult{Name: "no-empty-term-values", Status: checks.Fail, Message: "empty"}},
+			}},
+			Findings: [][]findings.Finding{{
+				{Location: findings.Location{Row: 3, Column: "term"}, Message: "empty term", Severity: checks.Fail},
+				{Location: findings.Location{Row: 5, Column: "term"}, Severity: checks.Warn},
+			}},
+		},
+	}
+
+	fs := collectFindings(outcomes)
+	if len(fs) != 4 {
+		t.Fatalf("got %d findings, want 4: %+v", len(fs), fs)
+	}
+
+	out := filepath.Join(t.TempDir(), "findings.tsv")
+	if err := writeFindings(out, fs); err != nil {
+		t.Fatalf("writeFindings: %v", err)
+	}
+	raw, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "file\tcheck\tcode\tstatus\trow\tcolumn\tmessage\tremediation\n" +
+		"a.csv\tensure-not-empty\tGG-EMPTY-FILE\tFAIL\t\t\tbroken row 3\tExport the glossary again; the file has no content.\n" +
+		"b.csv\t\t\tERROR\t\t\topen b.csv: no such file\t\n" +
+		"c.csv\tno-empty-term-values\tGG-EMPTY-TERM\tFAIL\t3\tterm\tempty term\tFill in the term for every row or delete rows without one. (https://github.com/bodrovis/lokalise-glossary-guard#column-structure)\n" +
+		"c.csv\tno-empty-term-values\tGG-EMPTY-TERM\tWARN\t5\tterm\tempty\tFill in the term for every row or delete rows without one. (https://github.com/bodrovis/lokalise-glossary-guard#column-structure)\n"
+	if string(raw) != want {
+		t.Fatalf("unexpected TSV:\n%s\nwant:\n%s", raw, want)
+	}
+}
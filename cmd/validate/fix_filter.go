@@ -0,0 +1,104 @@
+package validate
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+)
+
+// fixAllowed limits which checks may fix (--fix-only, --no-fix); nil lets
+// all of them.
+var fixAllowed func(name string) bool
+
+// checkSelected limits which checks run (--only, --skip); nil runs all.
+var checkSelected func(name string) bool
+
+// buildFixFilter turns --fix-only and --no-fix into fixAllowed.
+func buildFixFilter(only, skip []string) (func(name string) bool, error) {
+	return buildFilter(only, skip, "--fix-only", "--no-fix")
+}
+
+// buildFilter accepts the checks in only (or all when it is empty) that are
+// not in skip. onlyFlag and skipFlag name the flags in errors.
+func buildFilter(only, skip []string, onlyFlag, skipFlag string) (func(name string) bool, error) {
+	if len(only) == 0 && len(skip) == 0 {
+		return nil, nil
+	}
+	onlySet, err := resolveChecks(only, onlyFlag)
+	if err != nil {
+		return nil, err
+	}
+	skipSet, err := resolveChecks(skip, skipFlag)
+	if err != nil {
+		return nil, err
+	}
+	return func(name string) bool {
+		if len(onlySet) > 0 && !onlySet[name] {
+			return false
+		}
+		return !skipSet[name]
+	}, nil
+}
+
+// resolveChecks maps check names or rule codes (GG-…), in any case, to the
+// names of registered checks. Globs ("warn-*", "GG-HEADER*") stand for
+// every check whose name or code they match.
+func resolveChecks(vals []string, flag string) (map[string]bool, error) {
+	out := map[string]bool{}
+	for _, v := range vals {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if hasGlob(v) {
+			names, err := checksMatching(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %q: %w", flag, v, err)
+			}
+			if len(names) == 0 {
+				return nil, fmt.Errorf("%s: no check matches %q", flag, v)
+			}
+			for _, n := range names {
+				out[n] = true
+			}
+			continue
+		}
+		name, ok := checkByNameOrCode(v)
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown check %q (use a check name or rule code)", flag, v)
+		}
+		out[name] = true
+	}
+	return out, nil
+}
+
+func checkByNameOrCode(s string) (string, bool) {
+	for _, u := range checks.List() {
+		if strings.EqualFold(u.Name(), s) || strings.EqualFold(checkmeta.CodeFor(u.Name()), s) {
+			return u.Name(), true
+		}
+	}
+	return "", false
+}
+
+// checksMatching returns the checks whose name or rule code matches the
+// glob pattern, ignoring case.
+func checksMatching(pattern string) ([]string, error) {
+	pattern = strings.ToLower(pattern)
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, u := range checks.ListSorted() {
+		byName, _ := path.Match(pattern, strings.ToLower(u.Name()))
+		byCode, _ := path.Match(pattern, strings.ToLower(checkmeta.CodeFor(u.Name())))
+		if byName || byCode {
+			out = append(out, u.Name())
+		}
+	}
+	return out, nil
+}
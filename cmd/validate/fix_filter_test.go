@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildFixFilter(t *testing.T) {
+	allow, err := buildFixFilter([]string{"no-invalid-flags", "gg-whitespace"}, []string{"WARN-CELL-WHITESPACE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{
+		"no-invalid-flags":           true,
+		"warn-cell-whitespace":       false,
+		"warn-duplicate-term-values": false,
+	} {
+		if got := allow(name); got != want {
+			t.Errorf("allow(%q) = %v, want %v", name, got, want)
+		}
+	}
+
+	allow, err = buildFixFilter(nil, []string{"GG-DUPLICATE-TERM"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if allow("warn-duplicate-term-values") || !allow("no-invalid-flags") {
+		t.Error("--no-fix alone should only exclude the listed check")
+	}
+
+	if _, err := buildFixFilter([]string{"dedupe-terms"}, nil); err == nil || !strings.Contains(err.Error(), `unknown check "dedupe-terms"`) {
+		t.Fatalf("unknown check: err = %v", err)
+	}
+}
+
+func TestResolveChecksGlob(t *testing.T) {
+	got, err := resolveChecks([]string{"gg-header*", "ensure-utf8-*"}, "--only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"ensure-term-description-header", "no-spaces-in-header", "warn-duplicate-header-cells", "ensure-utf8-encoding"} {
+		if !got[name] {
+			t.Errorf("%s not matched: %v", name, got)
+		}
+	}
+	if got["ensure-semicolon-separators"] {
+		t.Error("ensure-semicolon-separators should not match")
+	}
+
+	if _, err := resolveChecks([]string{"GG-NOPE-*"}, "--skip"); err == nil || err.Error() != `--skip: no check matches "GG-NOPE-*"` {
+		t.Fatalf("err = %v", err)
+	}
+	if _, err := resolveChecks([]string{"warn-["}, "--skip"); err == nil {
+		t.Fatal("bad pattern accepted")
+	}
+}
+
+func TestPipelineSelection(t *testing.T) {
+	var err error
+	checkSelected, err = buildFilter([]string{"GG-UTF8", "GG-HEADER*"}, []string{"warn-*"}, "--only", "--skip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { checkSelected = nil })
+
+	var got []string
+	for _, u := range pipeline() {
+		got = append(got, u.Name())
+	}
+	want := "ensure-utf8-encoding,no-spaces-in-header,ensure-lowercase-header,ensure-term-description-header,ensure-allowed-columns-header"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("pipeline = %v, want %s", got, want)
+	}
+}
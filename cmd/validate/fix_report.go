@@ -0,0 +1,123 @@
+package validate
+
+import (
+	"encoding/json"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+	guard "github.com/bodrovis/lokalise-glossary-guard/pkg/validator"
+)
+
+// maxFixChanges caps the changes listed per applied fix; the fixed file
+// itself has everything.
+const maxFixChanges = 50
+
+// fixReport is the --fix-report document: what each fix changed, apart
+// from the validation report, so bots can describe the edits on a PR.
+type fixReport struct {
+	SchemaVersion int       `json:"schema_version"`
+	Files         []fixFile `json:"files"`
+}
+
+// fixFile lists the fixes applied to one input file.
+type fixFile struct {
+	Path string `json:"path"`
+	// Output is where the fixed content went, or would go with DryRun.
+	Output string `json:"output"`
+	Backup string `json:"backup,omitempty"` // --in-place
+	DryRun bool   `json:"dry_run"`
+	Error  string `json:"error,omitempty"` // writing the output failed
+	Fixes  []fix  `json:"fixes"`
+}
+
+// fix is one check that changed the file, in run order.
+type fix struct {
+	Check string `json:"check"`
+	Code  string `json:"code,omitempty"`
+	Note  string `json:"note,omitempty"`
+	// Lines are the line numbers, in the file as the check got it, that the
+	// fix removed or changed.
+	Lines   []int       `json:"lines"`
+	Changes []fixChange `json:"changes"`
+	// Truncated is set when there were more than maxFixChanges changes, or
+	// too many changed lines to diff (then Changes is empty).
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// fixChange replaces the Before lines, starting at Line, with the After
+// lines. Before is empty for inserted lines, After for removed ones.
+type fixChange struct {
+	Line   int      `json:"line"`
+	Before []string `json:"before"`
+	After  []string `json:"after"`
+}
+
+// buildFixFile describes every check of rep that changed the file, diffing
+// each check's input against its output. orig is the file as read.
+func buildFixFile(path string, orig []byte, rep guard.Report) fixFile {
+	ff := fixFile{Path: path, DryRun: fixDryRun, Fixes: []fix{}}
+	prev := orig
+	for i, o := range rep.Outcomes {
+		if i >= len(rep.Fixed) || rep.Fixed[i] == nil {
+			continue
+		}
+		cur := rep.Fixed[i]
+		f := fix{Check: o.Result.Name, Code: checkmeta.CodeFor(o.Result.Name), Note: o.Final.Note, Lines: []int{}, Changes: []fixChange{}}
+		ops, ok := diffLines(splitLines(prev), splitLines(cur))
+		if !ok {
+			f.Truncated = true
+		}
+		for _, c := range changesOf(ops) {
+			for k := range c.Before {
+				f.Lines = append(f.Lines, c.Line+k)
+			}
+			if len(f.Changes) == maxFixChanges {
+				f.Truncated = true
+				continue
+			}
+			f.Changes = append(f.Changes, c)
+		}
+		ff.Fixes = append(ff.Fixes, f)
+		prev = cur
+	}
+	return ff
+}
+
+// changesOf groups the edits of a diff into runs of removed and added
+// lines, numbered by the old file.
+func changesOf(ops []diffOp) []fixChange {
+	var out []fixChange
+	line := 1
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			line++
+			i++
+			continue
+		}
+		c := fixChange{Line: line, Before: []string{}, After: []string{}}
+		for ; i < len(ops) && ops[i].kind != ' '; i++ {
+			if ops[i].kind == '-' {
+				c.Before = append(c.Before, ops[i].line)
+				line++
+			} else {
+				c.After = append(c.After, ops[i].line)
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func writeFixReport(path string, outcomes []fileOutcome) error {
+	r := fixReport{SchemaVersion: 1, Files: []fixFile{}}
+	for _, oc := range outcomes {
+		if oc.Fixes != nil {
+			r.Files = append(r.Files, *oc.Fixes)
+		}
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,42 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+
+	guard "github.com/bodrovis/lokalise-glossary-guard/pkg/validator"
+)
+
+func TestBuildFixFile(t *testing.T) {
+	orig := []byte("term;description\nfile ;a\nb;x\nb;x\n")
+	step1 := []byte("term;description\nfile;a\nb;x\nb;x\n")
+	step2 := []byte("term;description\nfile;a\nb;x\n")
+	rep := guard.Report{
+		Summary: validator.Summary{Outcomes: []checks.CheckOutcome{
+			{Result: checks.CheckResult{Name: "warn-cell-whitespace"}, Final: checks.FixResult{DidChange: true, Note: "trimmed 1 cell(s)"}},
+			{Result: checks.CheckResult{Name: "no-empty-term-values"}},
+			{Result: checks.CheckResult{Name: "warn-duplicate-rows"}, Final: checks.FixResult{DidChange: true}},
+		}},
+		Fixed: [][]byte{step1, nil, step2},
+	}
+
+	ff := buildFixFile("g.csv", orig, rep)
+	want := []fix{
+		{
+			Check: "warn-cell-whitespace", Code: "GG-WHITESPACE", Note: "trimmed 1 cell(s)",
+			Lines:   []int{2},
+			Changes: []fixChange{{Line: 2, Before: []string{"file ;a"}, After: []string{"file;a"}}},
+		},
+		{
+			Check: "warn-duplicate-rows", Code: "GG-DUPLICATE-ROW",
+			Lines:   []int{4},
+			Changes: []fixChange{{Line: 4, Before: []string{"b;x"}, After: []string{}}},
+		},
+	}
+	if !reflect.DeepEqual(ff.Fixes, want) {
+		t.Fatalf("fixes:\n%+v\nwant:\n%+v", ff.Fixes, want)
+	}
+}
@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Where --fix writes fixed copies: the input name with fixSuffix before the
+// extension, next to the input or under fixOutputDir.
+var (
+	fixSuffix    = "_fixed"
+	fixOutputDir string
+)
+
+// fixedPath returns where the fixed copy of p goes. A name that already
+// ends in the suffix keeps it, so fixing a fixed copy does not pile up
+// suffixes. Under --fix-output-dir the path of p relative to the working
+// directory is kept; inputs outside it keep their full path below the
+// output directory.
+func fixedPath(p string) string {
+	ext := filepath.Ext(p)
+	base := strings.TrimSuffix(p, ext)
+	if !strings.HasSuffix(base, fixSuffix) {
+		base += fixSuffix
+	}
+	out := base + ext
+	if fixOutputDir == "" {
+		return out
+	}
+	return filepath.Join(fixOutputDir, relativeToWorkDir(out))
+}
+
+// relativeToWorkDir makes p relative to the working directory, or strips its
+// volume and root when it lies outside.
+func relativeToWorkDir(p string) string {
+	if !filepath.IsAbs(p) {
+		if rel := filepath.Clean(p); !strings.HasPrefix(rel, "..") {
+			return rel
+		}
+	}
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return filepath.Base(p)
+	}
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, abs); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return rel
+		}
+	}
+	return strings.TrimLeft(strings.TrimPrefix(abs, filepath.VolumeName(abs)), `/\`)
+}
@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFixedPath(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { fixSuffix, fixOutputDir = "_fixed", "" })
+
+	cases := []struct {
+		suffix, outDir, in, want string
+	}{
+		{"_fixed", "", "data/g.csv", "data/g_fixed.csv"},
+		{"_fixed", "", "data/g_fixed.csv", "data/g_fixed.csv"},
+		{".clean", "", "g.csv", "g.clean.csv"},
+		{"_fixed", "out", "data/g.csv", "out/data/g_fixed.csv"},
+		{"", "out", "data/g.csv", "out/data/g.csv"},
+		{"", "out", "./data/../g.csv", "out/g.csv"},
+		{"", "out", filepath.Join(wd, "data", "g.csv"), "out/data/g.csv"},
+		{"", "out", "/elsewhere/g.csv", "out/elsewhere/g.csv"},
+	}
+	for _, c := range cases {
+		fixSuffix, fixOutputDir = c.suffix, c.outDir
+		if got := fixedPath(filepath.FromSlash(c.in)); got != filepath.FromSlash(c.want) {
+			t.Errorf("fixedPath(%q) with suffix %q, dir %q = %q, want %q", c.in, c.suffix, c.outDir, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const (
+	groupByFile  = "file"
+	groupByCheck = "check"
+)
+
+type checkHit struct {
+	path    string
+	status  checks.Status
+	message string
+}
+
+type checkGroup struct {
+	name     string
+	critical bool
+	passed   int
+	hits     []checkHit
+}
+
+// groupByChecks regroups outcomes per check, preserving registry order and
+// input file order within each check.
+func groupByChecks(outcomes []fileOutcome) []*checkGroup {
+	var order []*checkGroup
+	byName := map[string]*checkGroup{}
+	get := func(name string) *checkGroup {
+		if g, ok := byName[name]; ok {
+			return g
+		}
+		g := &checkGroup{name: name}
+		if cu, ok := checks.Lookup(name); ok {
+			g.critical = cu.FailFast()
+		}
+		byName[name] = g
+		order = append(order, g)
+		return g
+	}
+	for _, u := range pipeline() {
+		get(u.Name())
+	}
+
+	for _, oc := range outcomes {
+		if oc.Summary == nil {
+			continue
+		}
+		for _, o := range oc.Summary.Outcomes {
+			g := get(o.Result.Name)
+			if o.Result.Status == checks.Pass {
+				g.passed++
+				continue
+			}
+			g.hits = append(g.hits, checkHit{
+				path:    oc.Path,
+				status:  o.Result.Status,
+				message: oneLine(strings.TrimSpace(o.Result.Message)),
+			})
+		}
+	}
+	return order
+}
+
+// writeGroupedByCheck prints each check once with the files that did not pass it.
+func writeGroupedByCheck(w io.Writer, outcomes []fileOutcome, sep string) {
+	fmt.Fprintf(w, "%s\n%s\n%s\n", sep, cyan("Results by check"), sep)
+
+	for _, g := range groupByChecks(outcomes) {
+		tag := "NORM"
+		if g.critical {
+			tag = "CRIT"
+		}
+		ran := g.passed + len(g.hits)
+		if len(g.hits) == 0 {
+			fmt.Fprintf(w, "→ [%s] %s ... %s (%d file(s))\n", tag, g.name, green("PASS"), ran)
+			continue
+		}
+		fmt.Fprintf(w, "→ [%s] %s ... %s in %d of %d file(s)\n", tag, g.name, red("NOT PASSED"), len(g.hits), ran)
+		for _, h := range g.hits {
+			fmt.Fprintf(w, "   %s %s: %s\n", colorStatus(string(h.status)), h.path, h.message)
+		}
+	}
+
+	var broken []fileOutcome
+	for _, oc := range outcomes {
+		if oc.OpError != "" {
+			broken = append(broken, oc)
+		}
+	}
+	if len(broken) > 0 {
+		fmt.Fprintf(w, "\n%s\n", red("Files with errors:"))
+		for _, oc := range broken {
+			fmt.Fprintf(w, "   %s: %s\n", oc.Path, oc.OpError)
+		}
+	}
+	fmt.Fprintln(w, sep)
+}
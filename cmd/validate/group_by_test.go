@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestGroupByChecks(t *testing.T) {
+	sum := func(status checks.Status) *validator.Summary {
+		return &validator.Summary{Outcomes: []checks.CheckOutcome{
+			{Result: checks.CheckResult{Name: "zz-test-check", Status: status, Message: "m"}},
+		}}
+	}
+	outcomes := []fileOutcome{
+		{Path: "a.csv", Summary: sum(checks.Fail)},
+		{Path: "b.csv", Summary: sum(checks.Pass)},
+		{Path: "c.csv", Summary: sum(checks.Warn)},
+	}
+
+	var g *checkGroup
+	for _, cg := range groupByChecks(outcomes) {
+		if cg.name == "zz-test-check" {
+			g = cg
+		}
+	}
+	if g == nil {
+		t.Fatal("check group missing")
+	}
+	if g.passed != 1 || len(g.hits) != 2 || g.hits[0].path != "a.csv" || g.hits[1].path != "c.csv" {
+		t.Fatalf("unexpected group: passed=%d hits=%+v", g.passed, g.hits)
+	}
+}
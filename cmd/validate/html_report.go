@@ -0,0 +1,195 @@
+package validate
+
+import (
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+)
+
+type htmlCheck struct {
+	Name    string
+	Code    string
+	Docs    string
+	Status  string
+	Changed bool
+	Message string
+	Note    string
+	Hint    string
+	Link    string
+}
+
+type htmlFile struct {
+	Path    string
+	Result  string
+	Class   string
+	Pass    int
+	Warn    int
+	Fail    int
+	Error   int
+	Early   string
+	Checks  []htmlCheck
+	Failure string
+}
+
+type htmlData struct {
+	Generated string
+	Duration  string
+	Files     []htmlFile
+	Passed    int
+	Warned    int
+	Failed    int
+}
+
+var htmlTmpl = template.Must(template.New("report").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Glossary validation report</title>
+<style>
+body{font-family:-apple-system,"Segoe UI",Roboto,Helvetica,Arial,sans-serif;margin:2rem;color:#222}
+h1{font-size:1.5rem}
+table{border-collapse:collapse;margin-bottom:2rem}
+th,td{border:1px solid #ddd;padding:.4rem .8rem;text-align:left}
+th{background:#f5f5f5}
+.pass{color:#1a7f37}.warn{color:#9a6700}.fail,.error{color:#cf222e}
+details{margin:.3rem 0}
+summary{cursor:pointer}
+.file{border:1px solid #ddd;border-radius:6px;padding:.8rem 1rem;margin-bottom:1rem}
+.msg{margin:.2rem 0 .2rem 1.5rem;white-space:pre-wrap}
+.muted{color:#666}
+.hint{color:#0b5394}
+</style>
+</head>
+<body>
+<h1>Glossary validation report</h1>
+<p class="muted">Generated {{.Generated}} in {{.Duration}}</p>
+<p>
+<span class="pass">{{.Passed}} passed</span>,
+<span class="warn">{{.Warned}} with warnings</span>,
+<span class="fail">{{.Failed}} failed</span>
+</p>
+<table>
+<tr><th>File</th><th>Result</th><th>Pass</th><th>Warn</th><th>Fail</th><th>Error</th></tr>
+{{- range .Files}}
+<tr><td>{{.Path}}</td><td class="{{.Class}}">{{.Result}}</td><td>{{.Pass}}</td><td>{{.Warn}}</td><td>{{.Fail}}</td><td>{{.Error}}</td></tr>
+{{- end}}
+</table>
+{{- range .Files}}
+<div class="file">
+<h2 class="{{.Class}}">{{.Path}}: {{.Result}}</h2>
+{{- if .Failure}}
+<p class="error">{{.Failure}}</p>
+{{- end}}
+{{- if .Early}}
+<p class="fail">{{.Early}}</p>
+{{- end}}
+{{- range .Checks}}
+<details>
+<summary>{{.Name}}{{if .Code}} <a class="muted" href="{{.Docs}}">{{.Code}}</a>{{end}}: <span class="{{lower .Status}}">{{.Status}}</span>{{if .Changed}} <span class="muted">[changed]</span>{{end}}</summary>
+<div class="msg">{{.Message}}</div>
+{{- if .Note}}
+<div class="msg muted">note: {{.Note}}</div>
+{{- end}}
+{{- if .Hint}}
+<div class="msg hint">hint: {{.Hint}}{{if .Link}} <a href="{{.Link}}">guidelines</a>{{end}}{{if .Docs}} · <a href="{{.Docs}}">rule docs</a>{{end}}</div>
+{{- end}}
+</details>
+{{- end}}
+</div>
+{{- end}}
+</body>
+</html>
+`))
+
+// writeHTMLReport renders all outcomes into a single static HTML file.
+func writeHTMLReport(path string, outcomes []fileOutcome, elapsed time.Duration) error {
+	data := htmlData{
+		Generated: time.Now().Format(time.RFC1123),
+		Duration:  elapsed.Round(time.Millisecond).String(),
+		Files:     make([]htmlFile, 0, len(outcomes)),
+	}
+
+	for _, oc := range outcomes {
+		hf := htmlFile{Path: oc.Path}
+		hf.Result, hf.Class = fileResult(oc)
+		switch hf.Class {
+		case "pass":
+			data.Passed++
+		case "warn":
+			data.Warned++
+		default:
+			data.Failed++
+		}
+
+		hf.Failure = oc.OpError
+		if oc.Summary == nil {
+			if hf.Failure == "" {
+				hf.Failure = "file could not be validated"
+			}
+			data.Files = append(data.Files, hf)
+			continue
+		}
+
+		sum := oc.Summary
+		hf.Pass, hf.Warn, hf.Fail, hf.Error = sum.Pass, sum.Warn, sum.Fail, sum.Error
+		if sum.EarlyExit {
+			hf.Early = fmt.Sprintf("Stopped early due to fail-fast in check %q (%s).", sum.EarlyCheck, sum.EarlyStatus)
+			if len(oc.Skipped) > 0 {
+				hf.Early += " Skipped: " + strings.Join(oc.Skipped, ", ") + "."
+			}
+		}
+		for _, o := range sum.Outcomes {
+			hc := htmlCheck{
+				Name:    o.Result.Name,
+				Code:    checkmeta.CodeFor(o.Result.Name),
+				Docs:    checkmeta.DocsURLFor(o.Result.Name),
+				Status:  string(o.Result.Status),
+				Changed: o.Final.DidChange,
+				Message: strings.TrimSpace(o.Result.Message),
+				Note:    strings.TrimSpace(o.Final.Note),
+			}
+			if o.Result.Status != checks.Pass {
+				rem := checkmeta.RemediationFor(o.Result.Name)
+				hc.Hint, hc.Link = rem.Hint, rem.Link
+			}
+			hf.Checks = append(hf.Checks, hc)
+		}
+		data.Files = append(data.Files, hf)
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := htmlTmpl.Execute(f, data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// fileResult returns the per-file verdict and a CSS-friendly class for it.
+func fileResult(oc fileOutcome) (label, class string) {
+	switch {
+	case oc.Failed > 0:
+		return "FAILED", "fail"
+	case oc.HadOpErr:
+		return "ERROR", "error"
+	case oc.Warned > 0:
+		return "PASSED WITH WARNINGS", "warn"
+	default:
+		return "PASSED", "pass"
+	}
+}
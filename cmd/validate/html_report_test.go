@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestWriteHTMLReport(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "nested", "report.html")
+	outcomes := []fileOutcome{
+		{
+			Path:   "ok.csv",
+			Passed: 1,
+			Summary: &validator.Summary{
+				Pass: 1,
+				Outcomes: []checks.CheckOutcome{
+					{Result: checks.CheckResult{Name: "ensure-not-empty", Status: checks.Pass, Message: "file has <content>"}},
+				},
+			},
+		},
+		{Path: "missing.csv", HadOpErr: true, Errored: 1},
+	}
+
+	if err := writeHTMLReport(out, outcomes, time.Second); err != nil {
+		t.Fatalf("writeHTMLReport: %v", err)
+	}
+	raw, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := string(raw)
+	for _, want := range []string{"ok.csv", "missing.csv", "ensure-not-empty", "file has &lt;content&gt;", "<details>", "1 passed"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("report does not contain %q", want)
+		}
+	}
+}
+
+func TestFileResult(t *testing.T) {
+	cases := []struct {
+		oc   fileOutcome
+		want string
+	}{
+		{fileOutcome{Passed: 1}, "PASSED"},
+		{fileOutcome{Warned: 1}, "PASSED WITH WARNINGS"},
+		{fileOutcome{Failed: 1, HadValFail: true}, "FAILED"},
+		{fileOutcome{HadOpErr: true, Errored: 1}, "ERROR"},
+	}
+	for _, c := range cases {
+		if got, _ := fileResult(c.oc); got != c.want {
+			t.Errorf("fileResult(%+v) = %q, want %q", c.oc, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/undo"
+)
+
+// backupStamp names the backups of one run, so all files share it.
+var backupStamp string
+
+// undoRun journals what a --fix run writes for undo-fix; nil with
+// --fix-dry-run or an empty --undo-dir.
+var (
+	undoDir = undo.DefaultDir
+	undoRun *undo.Run
+)
+
+// writeInPlace replaces path with fixed after saving orig, its current
+// content, as a backup. It returns where the backup went. The original
+// file keeps its permissions and is replaced atomically.
+func writeInPlace(path string, orig, fixed []byte) (string, error) {
+	perm := fs.FileMode(0o644)
+	if fi, err := os.Stat(path); err == nil {
+		perm = fi.Mode().Perm()
+	}
+	bak, err := backup(path, orig, perm)
+	if err != nil {
+		return "", fmt.Errorf("backup: %w", err)
+	}
+	return bak, fsutil.WriteFile(path, fixed, perm)
+}
+
+// backup writes data to <name>.bak-<stamp> next to path, or in --backup-dir.
+// An existing backup is never overwritten; a counter is appended instead,
+// as when two files with the same name share a backup directory.
+func backup(path string, data []byte, perm fs.FileMode) (string, error) {
+	dir := filepath.Dir(path)
+	if backupDir != "" {
+		dir = backupDir
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return "", err
+		}
+	}
+	base := filepath.Join(dir, filepath.Base(path)+".bak-"+backupStamp)
+	for n := 1; ; n++ {
+		p := base
+		if n > 1 {
+			p = fmt.Sprintf("%s-%d", base, n)
+		}
+		f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		_, werr := f.Write(data)
+		if werr == nil {
+			// The original is replaced next; the backup must be on disk first.
+			werr = f.Sync()
+		}
+		if err := errors.Join(werr, f.Close()); err != nil {
+			_ = os.Remove(p)
+			return "", err
+		}
+		return p, nil
+	}
+}
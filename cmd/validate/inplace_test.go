@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteInPlace(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "g.csv")
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	backupStamp = "20260102-150405"
+	t.Cleanup(func() { backupStamp, backupDir = "", "" })
+
+	bak, err := writeInPlace(path, []byte("old"), []byte("new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := path + ".bak-20260102-150405"; bak != want {
+		t.Fatalf("backup at %s, want %s", bak, want)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "new" {
+		t.Fatalf("file = %q", got)
+	}
+	if fi, _ := os.Stat(path); fi.Mode().Perm() != 0o600 {
+		t.Fatalf("mode = %v", fi.Mode())
+	}
+
+	// A second backup in the same second and directory does not overwrite
+	// the first.
+	backupDir = filepath.Join(dir, "backups")
+	for _, want := range []string{"g.csv.bak-20260102-150405", "g.csv.bak-20260102-150405-2"} {
+		bak, err := writeInPlace(path, []byte("new"), []byte("newer"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bak != filepath.Join(backupDir, want) {
+			t.Fatalf("backup at %s, want %s", bak, want)
+		}
+	}
+}
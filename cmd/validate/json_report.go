@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+// buildReport converts outcomes into the versioned JSON report.
+func buildReport(outcomes []fileOutcome) report.Report {
+	r := report.Report{
+		SchemaVersion: report.SchemaVersion,
+		Result:        runResult(outcomes),
+		Files:         make([]report.File, 0, len(outcomes)),
+	}
+	for _, oc := range outcomes {
+		r.Files = append(r.Files, toReportFile(oc))
+	}
+	return r
+}
+
+// toReportFile converts a single outcome.
+func toReportFile(oc fileOutcome) report.File {
+	f := report.File{
+		Path:       oc.Path,
+		Passed:     oc.Passed,
+		Warned:     oc.Warned,
+		Failed:     oc.Failed,
+		Errored:    oc.Errored,
+		HadOpErr:   oc.HadOpErr,
+		HadValFail: oc.HadValFail,
+		Modified:   oc.Modified,
+		OpError:    oc.OpError,
+		DurationMS: millis(oc.Duration),
+		Stats:      oc.Stats,
+	}
+	if oc.Summary != nil {
+		f.Summary = report.NewSummary(*oc.Summary)
+		f.Summary.Skipped = oc.Skipped
+		for i := range f.Summary.Checks {
+			f.Summary.Checks[i].Code = checkmeta.CodeFor(f.Summary.Checks[i].Name)
+		}
+		for i, d := range oc.CheckDurations {
+			if i < len(f.Summary.Checks) {
+				f.Summary.Checks[i].DurationMS = millis(d)
+			}
+		}
+		for i, fds := range oc.Findings {
+			if i < len(f.Summary.Checks) {
+				f.Summary.Checks[i].Findings = fds
+			}
+		}
+	}
+	return f
+}
+
+// millis converts d to milliseconds with microsecond precision.
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
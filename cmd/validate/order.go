@@ -0,0 +1,184 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/history"
+	guard "github.com/bodrovis/lokalise-glossary-guard/pkg/validator"
+)
+
+const (
+	orderPriority = "priority"
+	orderSmart    = "smart"
+)
+
+// hist is loaded for --order smart only.
+var hist *history.Store
+
+func historyPath() string {
+	if historyFile != "" {
+		return historyFile
+	}
+	return history.DefaultPath()
+}
+
+func loadHistory() error {
+	if orderBy != orderSmart {
+		return nil
+	}
+	s, err := history.Load(historyPath())
+	if err != nil {
+		return fmt.Errorf("load history: %w", err)
+	}
+	hist = s
+	return nil
+}
+
+// The order section of the config, resolved to check names.
+var (
+	priorities map[string]int
+	extraAfter map[string][]string
+)
+
+// buildOrder resolves the order section of the config. Configured
+// priorities that would run a check before one it depends on are an error;
+// configured dependencies are kept by moving checks down.
+func buildOrder() error {
+	o := config.Get().Order
+	priorities, extraAfter = nil, nil
+	for _, k := range sortedKeys(o.Priorities) {
+		name, ok := checkByNameOrCode(k)
+		if !ok {
+			return fmt.Errorf("order.priorities: unknown check %q (use a check name or rule code)", k)
+		}
+		if priorities == nil {
+			priorities = map[string]int{}
+		}
+		priorities[name] = o.Priorities[k]
+	}
+	for _, k := range sortedKeys(o.After) {
+		name, ok := checkByNameOrCode(k)
+		if !ok {
+			return fmt.Errorf("order.after: unknown check %q (use a check name or rule code)", k)
+		}
+		for _, d := range o.After[k] {
+			dep, ok := checkByNameOrCode(d)
+			if !ok {
+				return fmt.Errorf("order.after.%s: unknown check %q (use a check name or rule code)", k, d)
+			}
+			if extraAfter == nil {
+				extraAfter = map[string][]string{}
+			}
+			extraAfter[name] = append(extraAfter[name], dep)
+		}
+	}
+	if len(priorities) == 0 {
+		return nil
+	}
+	units := guard.WithPriorities(checks.ListSorted(), priorities)
+	if check, dep, ok := guard.Unmet(units, dependsOn); ok {
+		return fmt.Errorf("order.priorities: %s (priority %d) would run before %s (priority %d), which its fix depends on",
+			check, priorityOf(units, check), dep, priorityOf(units, dep))
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func priorityOf(units []checks.CheckUnit, name string) int {
+	for _, u := range units {
+		if u.Name() == name {
+			return u.Priority()
+		}
+	}
+	return 0
+}
+
+// dependsOn lists the checks that must run before name: those its metadata
+// declares and those configured under order.after.
+func dependsOn(name string) []string {
+	return append(append([]string(nil), checkmeta.AfterFor(name)...), extraAfter[name]...)
+}
+
+// pipeline returns the selected checks in run order: by priority, as
+// configured, and each after the checks it depends on.
+func pipeline() []checks.CheckUnit {
+	units := checks.ListSorted()
+	if len(priorities) > 0 {
+		units = guard.WithPriorities(units, priorities)
+	}
+	units = guard.KeepAfter(units, dependsOn)
+	if checkSelected != nil {
+		units = slices.DeleteFunc(units, func(u checks.CheckUnit) bool { return !checkSelected(u.Name()) })
+	}
+	return units
+}
+
+// writeOrder prints the pipeline for --print-order.
+func writeOrder(w io.Writer) {
+	units := pipeline()
+	width := len("CHECK")
+	for _, u := range units {
+		width = max(width, len(u.Name())+1)
+	}
+	fmt.Fprintf(w, "%-8s  %-*s  %-22s  %s\n", "PRIORITY", width, "CHECK", "CODE", "RUNS AFTER")
+	for _, u := range units {
+		name := u.Name()
+		if u.FailFast() {
+			name += "*"
+		}
+		line := fmt.Sprintf("%8d  %-*s  %-22s  %s", u.Priority(), width, name, checkmeta.CodeFor(u.Name()), strings.Join(dependsOn(u.Name()), ", "))
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+	fmt.Fprintln(w, "\n* critical: a failure stops the checks below it")
+}
+
+// unitsFor returns the check sequence for a file: the pipeline, or with
+// --order smart the checks that failed most often on it moved up as far as
+// their dependencies allow. Checks excluded by --fix-only or --no-fix only
+// validate.
+func unitsFor(path string) []checks.CheckUnit {
+	units := pipeline()
+	if hist != nil {
+		units = guard.ReorderNonCritical(units, func(name string) float64 {
+			return hist.FailureRate(path, name)
+		})
+		units = guard.KeepAfter(units, dependsOn)
+	}
+	if fixAllowed != nil {
+		units = guard.WithFixes(units, fixAllowed)
+	}
+	return units
+}
+
+// saveHistory records this run; failing to save only warns, since the
+// validation itself succeeded.
+func saveHistory(outcomes []fileOutcome) {
+	if hist == nil {
+		return
+	}
+	for _, oc := range outcomes {
+		if oc.Summary != nil {
+			hist.Record(oc.Path, oc.Summary.Outcomes)
+		}
+	}
+	if err := hist.Save(historyPath()); err != nil {
+		fmt.Fprintln(os.Stderr, yellow(fmt.Sprintf("failed to save history: %v", err)))
+	}
+}
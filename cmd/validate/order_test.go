@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func pipelineNames() []string {
+	var out []string
+	for _, u := range pipeline() {
+		out = append(out, u.Name())
+	}
+	return out
+}
+
+func TestBuildOrder(t *testing.T) {
+	t.Cleanup(func() {
+		config.Set(nil)
+		priorities, extraAfter = nil, nil
+	})
+
+	// Rule codes work as keys, and a check can be moved within its
+	// dependencies.
+	config.Set(&config.Config{Order: config.Order{Priorities: map[string]int{"GG-TYPOGRAPHY": 27}}})
+	if err := buildOrder(); err != nil {
+		t.Fatal(err)
+	}
+	names := pipelineNames()
+	if indexOf(names, "warn-typography") > indexOf(names, "warn-cell-whitespace") {
+		t.Errorf("warn-typography should run before warn-cell-whitespace: %v", names)
+	}
+
+	// Moving a cell-level fixer before delimiter conversion is refused.
+	config.Set(&config.Config{Order: config.Order{Priorities: map[string]int{"warn-cell-whitespace": 1}}})
+	err := buildOrder()
+	if err == nil || !strings.Contains(err.Error(), "warn-cell-whitespace (priority 1) would run before ensure-semicolon-separators (priority 6)") {
+		t.Fatalf("err = %v", err)
+	}
+
+	// Configured dependencies move checks down.
+	config.Set(&config.Config{Order: config.Order{After: map[string][]string{"warn-cell-whitespace": {"GG-TYPOGRAPHY"}}}})
+	if err := buildOrder(); err != nil {
+		t.Fatal(err)
+	}
+	names = pipelineNames()
+	if indexOf(names, "warn-cell-whitespace") < indexOf(names, "warn-typography") {
+		t.Errorf("warn-cell-whitespace should run after warn-typography: %v", names)
+	}
+	var buf bytes.Buffer
+	writeOrder(&buf)
+	if !strings.Contains(buf.String(), "warn-cell-whitespace") || !strings.Contains(buf.String(), "ensure-semicolon-separators, warn-typography") {
+		t.Errorf("print-order output:\n%s", buf.String())
+	}
+
+	config.Set(&config.Config{Order: config.Order{After: map[string][]string{"warn-typography": {"nope"}}}})
+	if err := buildOrder(); err == nil || !strings.Contains(err.Error(), `unknown check "nope"`) {
+		t.Fatalf("err = %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limits for glossaries given by URL in --files.
+var (
+	fetchTimeout       = 30 * time.Second
+	fetchMaxSize int64 = 50 << 20
+)
+
+var (
+	httpClient = &http.Client{}
+	// fetched holds each URL of --files with its content or the error
+	// fetching it, which is reported like an unreadable file.
+	fetched map[string]fetchResult
+)
+
+type fetchResult struct {
+	data []byte
+	err  error
+}
+
+func isURL(p string) bool {
+	l := strings.ToLower(p)
+	return strings.HasPrefix(l, "http://") || strings.HasPrefix(l, "https://")
+}
+
+// fetchRemote downloads the URLs among paths, up to maxParallel at a time.
+func fetchRemote(ctx context.Context, paths []string) {
+	var urls []string
+	for _, p := range paths {
+		if isURL(p) {
+			urls = append(urls, p)
+		}
+	}
+	if len(urls) == 0 {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	fetched = make(map[string]fetchResult, len(urls))
+	var mu sync.Mutex
+	sem := make(chan struct{}, max(1, int(maxParallel)))
+	var wg sync.WaitGroup
+	for _, u := range urls {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			data, err := fetch(ctx, u)
+			mu.Lock()
+			fetched[u] = fetchResult{data: data, err: err}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+}
+
+func fetch(ctx context.Context, u string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch: GET %s: %s", u, resp.Status)
+	}
+	if resp.ContentLength > fetchMaxSize {
+		return nil, fmt.Errorf("fetch: %s is larger than %d bytes (--fetch-max-size)", u, fetchMaxSize)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, fetchMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("fetch: GET %s: %w", u, err)
+	}
+	if int64(len(data)) > fetchMaxSize {
+		return nil, fmt.Errorf("fetch: %s is larger than %d bytes (--fetch-max-size)", u, fetchMaxSize)
+	}
+	return data, nil
+}
+
+// sourcePath is the name the checks see for an input. For a URL it is the
+// host and path, so the extension check ignores query strings and the fixed
+// copy lands in a directory named after the host.
+func sourcePath(p string) string {
+	if !isURL(p) {
+		return p
+	}
+	u, err := url.Parse(p)
+	if err != nil {
+		return p
+	}
+	host := u.Hostname()
+	if port := u.Port(); port != "" {
+		host += "_" + port
+	}
+	name := path.Clean("/" + u.Path)
+	if name == "/" {
+		name = "/remote.csv"
+	}
+	return filepath.Join(host, filepath.FromSlash(name))
+}
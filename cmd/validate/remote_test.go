@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFetchRemote(t *testing.T) {
+	const csv = "term;description\nAPI;Interface\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/glossary.csv":
+			_, _ = w.Write([]byte(csv))
+		case "/big.csv":
+			_, _ = w.Write([]byte(strings.Repeat("x", 100)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	fetchMaxSize = 64
+	t.Cleanup(func() { fetchMaxSize, fetched = 50<<20, nil })
+
+	ok, missing, big := srv.URL+"/glossary.csv?raw=1", srv.URL+"/missing.csv", srv.URL+"/big.csv"
+	paths, err := expandFiles([]string{ok, missing, big})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 3 || paths[0] != ok {
+		t.Fatalf("URLs should pass through as given: %v", paths)
+	}
+	fetchRemote(context.Background(), paths)
+
+	if data, err := readInput(ok); err != nil || string(data) != csv {
+		t.Errorf("readInput(ok) = %q, %v", data, err)
+	}
+	if _, err := readInput(missing); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("missing: err = %v", err)
+	}
+	if _, err := openInput(big); err == nil || !strings.Contains(err.Error(), "larger than") {
+		t.Errorf("big: err = %v", err)
+	}
+}
+
+func TestSourcePath(t *testing.T) {
+	cases := map[string]string{
+		"data/g.csv": "data/g.csv",
+		"https://example.com/team/glossary.csv?raw=1": "example.com/team/glossary.csv",
+		"http://127.0.0.1:8080/g.csv#top":             "127.0.0.1_8080/g.csv",
+		"https://example.com":                         "example.com/remote.csv",
+		"HTTPS://example.com/../../etc/g.csv":         "example.com/etc/g.csv",
+	}
+	for in, want := range cases {
+		if got := sourcePath(in); got != filepath.FromSlash(want) {
+			t.Errorf("sourcePath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
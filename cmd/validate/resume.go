@@ -0,0 +1,203 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultResumeFile records the files a --fix run has finished, so that
+// --resume can skip them after an interruption.
+const defaultResumeFile = ".glossaryguard-resume.jsonl"
+
+var (
+	resume     bool
+	resumeFile = defaultResumeFile
+	// progress is the resume file of a --fix run; nil otherwise.
+	progress *resumeLog
+)
+
+// resumeHeader is the first line of the resume file. Fix is the set of fix
+// options, so a run with other options does not pick up the state.
+type resumeHeader struct {
+	Fix string `json:"fix"`
+}
+
+// resumeEntry is a finished file: its results, and the hash of its content
+// when it was done, so a file changed since is processed again.
+type resumeEntry struct {
+	Path       string `json:"path"`
+	SHA256     string `json:"sha256"`
+	Passed     int    `json:"passed"`
+	Warned     int    `json:"warned"`
+	Failed     int    `json:"failed"`
+	HadValFail bool   `json:"had_val_fail"`
+	Modified   bool   `json:"modified"`
+}
+
+// resumeLog appends finished files to the resume file.
+type resumeLog struct {
+	path string
+	f    *os.File
+	done map[string]resumeEntry // from the interrupted run, by absolute path
+}
+
+// fixOptions describes the options that decide what a fix run writes.
+func fixOptions() string {
+	return fmt.Sprintf("in-place=%v suffix=%q output-dir=%q only=%q skip=%q fix-only=%q no-fix=%q rerun=%v",
+		inPlace, fixSuffix, fixOutputDir, strings.Join(onlyChecks, ","), strings.Join(skipChecks, ","),
+		strings.Join(fixOnly, ","), strings.Join(noFix, ","), rerunAfterFix)
+}
+
+// openResumeLog starts the resume file of a fix run. With --resume the files
+// an earlier run with the same options finished are kept; otherwise any
+// earlier state is discarded.
+func openResumeLog(path string, keep bool) (*resumeLog, error) {
+	l := &resumeLog{path: path, done: map[string]resumeEntry{}}
+	header := resumeHeader{Fix: fixOptions()}
+	if keep {
+		raw, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			keep = false
+		case err != nil:
+			return nil, fmt.Errorf("read resume file: %w", err)
+		default:
+			if err := l.parse(raw, header); err != nil {
+				return nil, fmt.Errorf("resume file %s: %w", path, err)
+			}
+		}
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if !keep {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open resume file: %w", err)
+	}
+	l.f = f
+	if !keep {
+		if err := l.append(header); err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+func (l *resumeLog) parse(raw []byte, want resumeHeader) error {
+	sc := bufio.NewScanner(bytes.NewReader(raw))
+	sc.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if n == 1 {
+			var h resumeHeader
+			if err := json.Unmarshal(line, &h); err != nil {
+				return fmt.Errorf("line 1: %w", err)
+			}
+			if h != want {
+				return fmt.Errorf("written by a run with other fix options (%s); rerun without --resume", h.Fix)
+			}
+			continue
+		}
+		var e resumeEntry
+		if err := json.Unmarshal(line, &e); err != nil {
+			// The interrupted run may have died mid-line; the file is
+			// simply not done.
+			continue
+		}
+		l.done[e.Path] = e
+	}
+	return sc.Err()
+}
+
+// append writes v as one line and syncs it, so a finished file stays
+// recorded whatever ends the run.
+func (l *resumeLog) append(v any) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := l.f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write resume file: %w", err)
+	}
+	return l.f.Sync()
+}
+
+// finished returns the outcome recorded for path by the interrupted run,
+// if the file still has the content it had then.
+func (l *resumeLog) finished(idx int, path string) (fileOutcome, bool) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return fileOutcome{}, false
+	}
+	e, ok := l.done[abs]
+	if !ok || fileDigest(path) != e.SHA256 {
+		return fileOutcome{}, false
+	}
+	b := newSpillBuffer(spillThreshold)
+	sep := strings.Repeat("─", 72)
+	fmt.Fprintf(b, "%s\n%s: %s\n%s\n\n", sep, cyan("Validating"), path, sep)
+	fmt.Fprintf(b, "%s finished by the interrupted run, skipped (--resume)\n", cyan("Info"))
+	fmt.Fprintf(b, "%s\n", sep)
+	return fileOutcome{
+		Idx: idx, Path: path, Output: b,
+		Passed: e.Passed, Warned: e.Warned, Failed: e.Failed,
+		HadValFail: e.HadValFail, Modified: e.Modified,
+		Resumed: true,
+	}, true
+}
+
+// record notes a file this run finished. Files that could not be processed,
+// or were cut off by the interruption, are left for the next run.
+func (l *resumeLog) record(oc fileOutcome) error {
+	if oc.Resumed || oc.HadOpErr || oc.Path == "" {
+		return nil
+	}
+	abs, err := filepath.Abs(oc.Path)
+	if err != nil {
+		return err
+	}
+	return l.append(resumeEntry{
+		Path: abs, SHA256: fileDigest(oc.Path),
+		Passed: oc.Passed, Warned: oc.Warned, Failed: oc.Failed,
+		HadValFail: oc.HadValFail, Modified: oc.Modified,
+	})
+}
+
+// close ends the log. When every file is done the resume file is removed:
+// there is nothing left to resume.
+func (l *resumeLog) close(outcomes []fileOutcome) error {
+	err := l.f.Close()
+	for _, oc := range outcomes {
+		if oc.Path == "" || oc.HadOpErr {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return os.Remove(l.path)
+}
+
+func fileDigest(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResumeLog(t *testing.T) {
+	dir := t.TempDir()
+	state := filepath.Join(dir, defaultResumeFile)
+	a, b := filepath.Join(dir, "a.csv"), filepath.Join(dir, "b.csv")
+	for _, p := range []string{a, b} {
+		if err := os.WriteFile(p, []byte("term;description\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() { inPlace = false })
+
+	// The first run finishes a, then is cut off before b.
+	l, err := openResumeLog(state, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.record(fileOutcome{Path: a, Warned: 1, Modified: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.record(fileOutcome{Path: b, HadOpErr: true}); err != nil {
+		t.Fatal(err)
+	}
+	_ = l.f.Close()
+
+	l, err = openResumeLog(state, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oc, ok := l.finished(0, a)
+	if !ok || !oc.Resumed || oc.Warned != 1 || !oc.Modified {
+		t.Fatalf("a: %+v, %v", oc, ok)
+	}
+	if _, ok := l.finished(1, b); ok {
+		t.Fatal("b was not finished")
+	}
+	if err := l.record(oc); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.record(fileOutcome{Path: b, Passed: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A file changed since it was finished is processed again.
+	if err := os.WriteFile(a, []byte("term;description\nx;y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := l.finished(0, a); ok {
+		t.Fatal("changed file was skipped")
+	}
+
+	// Everything done: nothing is left to resume.
+	if err := l.close([]fileOutcome{{Path: a}, {Path: b}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(state); !os.IsNotExist(err) {
+		t.Fatalf("resume file left behind: %v", err)
+	}
+
+	// State of a run with other fix options is refused.
+	l, err = openResumeLog(state, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = l.f.Close()
+	inPlace = true
+	if _, err := openResumeLog(state, true); err == nil || !strings.Contains(err.Error(), "other fix options") {
+		t.Fatalf("err = %v", err)
+	}
+}
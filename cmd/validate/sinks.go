@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+// ToolVersion is reported by machine-readable outputs; set by the root command.
+var ToolVersion = "dev"
+
+// fileSink receives file results in input order as soon as they are ready,
+// so streaming outputs never need the whole run in memory.
+type fileSink interface {
+	WriteFile(f report.File) error
+	Close() error
+}
+
+// fileBackedSink owns the output file of a streaming writer.
+type fileBackedSink struct {
+	f *os.File
+	w fileSink
+}
+
+func (s *fileBackedSink) WriteFile(f report.File) error { return s.w.WriteFile(f) }
+
+func (s *fileBackedSink) Close() error {
+	err := s.w.Close()
+	if cerr := s.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// openSinks creates the streaming report outputs requested via flags.
+func openSinks() ([]fileSink, error) {
+	var sinks []fileSink
+	open := func(path string, mk func(f *os.File) fileSink) error {
+		if dir := filepath.Dir(path); dir != "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return err
+			}
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, &fileBackedSink{f: f, w: mk(f)})
+		return nil
+	}
+
+	if sarifOut != "" {
+		err := open(sarifOut, func(f *os.File) fileSink {
+			return report.NewSARIFWriter(f, ToolVersion, sarifRules())
+		})
+		if err != nil {
+			closeSinks(sinks)
+			return nil, err
+		}
+	}
+	if junitOut != "" {
+		err := open(junitOut, func(f *os.File) fileSink {
+			return report.NewJUnitWriter(f)
+		})
+		if err != nil {
+			closeSinks(sinks)
+			return nil, err
+		}
+	}
+	return sinks, nil
+}
+
+func closeSinks(sinks []fileSink) error {
+	var first error
+	for _, s := range sinks {
+		if err := s.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func sarifRules() []report.Rule {
+	units := checks.ListSorted()
+	rules := make([]report.Rule, 0, len(units))
+	for _, u := range units {
+		m, _ := checkmeta.Lookup(u.Name())
+		id := m.Code
+		if id == "" {
+			id = u.Name()
+		}
+		helpURI := m.DocsURL()
+		if helpURI == "" {
+			helpURI = m.Remediation.Link
+		}
+		rules = append(rules, report.Rule{ID: id, Name: u.Name(), FailFast: u.FailFast(), Help: m.Remediation.String(), HelpURI: helpURI})
+	}
+	return rules
+}
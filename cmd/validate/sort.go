@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"slices"
+	"sort"
+)
+
+const (
+	sortInput    = "input"
+	sortStatus   = "status"
+	sortPath     = "path"
+	sortDuration = "duration"
+)
+
+var sortModes = []string{sortInput, sortStatus, sortPath, sortDuration}
+
+func validSort(s string) bool { return slices.Contains(sortModes, s) }
+
+// severity ranks a file result; higher is worse.
+func severity(oc fileOutcome) int {
+	switch _, class := fileResult(oc); class {
+	case "error":
+		return 3
+	case "fail":
+		return 2
+	case "warn":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortOutcomes reorders outcomes for the final report. Ties keep input order.
+func sortOutcomes(outcomes []fileOutcome, mode string) {
+	var less func(a, b fileOutcome) bool
+	switch mode {
+	case sortStatus:
+		less = func(a, b fileOutcome) bool { return severity(a) > severity(b) }
+	case sortPath:
+		less = func(a, b fileOutcome) bool { return a.Path < b.Path }
+	case sortDuration:
+		less = func(a, b fileOutcome) bool { return a.Duration > b.Duration }
+	default:
+		return
+	}
+	sort.SliceStable(outcomes, func(i, j int) bool { return less(outcomes[i], outcomes[j]) })
+}
@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortOutcomes(t *testing.T) {
+	mk := func() []fileOutcome {
+		return []fileOutcome{
+			{Path: "c.csv", Passed: 1, Duration: 2 * time.Second},
+			{Path: "a.csv", Failed: 1, Duration: time.Second},
+			{Path: "b.csv", Warned: 1, Duration: 3 * time.Second},
+			{Path: "d.csv", HadOpErr: true},
+		}
+	}
+	paths := func(ocs []fileOutcome) string {
+		s := ""
+		for _, oc := range ocs {
+			s += oc.Path[:1]
+		}
+		return s
+	}
+
+	cases := map[string]string{
+		sortInput:    "cabd",
+		sortStatus:   "dabc",
+		sortPath:     "abcd",
+		sortDuration: "bcad",
+	}
+	for mode, want := range cases {
+		ocs := mk()
+		sortOutcomes(ocs, mode)
+		if got := paths(ocs); got != want {
+			t.Errorf("sort %s: got %s, want %s", mode, got, want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"bytes"
+	"io"
+	"os"
+)
+
+// spillThreshold is how much rendered output a single file may keep in memory
+// before it is moved to a temporary file.
+const spillThreshold = 64 << 10
+
+// spillBuffer collects one file's rendered report. It stays in memory while
+// small and spills to a temp file past its limit, so large batches with huge
+// per-file reports don't hold everything in RAM until ordered printing. The
+// temp file is only open while it is written or replayed, so a batch with
+// many spilled reports does not run out of file descriptors.
+type spillBuffer struct {
+	mem   bytes.Buffer
+	name  string // temp file, once spilled
+	limit int
+	err   error
+}
+
+func newSpillBuffer(limit int) *spillBuffer {
+	return &spillBuffer{limit: limit}
+}
+
+// Write buffers p in memory and, once the buffer is past its limit, appends
+// the buffered content to the temp file.
+func (b *spillBuffer) Write(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	n, _ := b.mem.Write(p)
+	if b.mem.Len() > b.limit {
+		b.spill()
+	}
+	return n, b.err
+}
+
+func (b *spillBuffer) WriteByte(c byte) error {
+	_, err := b.Write([]byte{c})
+	return err
+}
+
+// spill moves the in-memory content to the temp file. If no temp file can be
+// created the buffer keeps working in memory; only file write errors are
+// sticky.
+func (b *spillBuffer) spill() {
+	if b.name == "" {
+		f, err := os.CreateTemp("", "glossary-guard-*.out")
+		if err != nil {
+			b.limit = int(^uint(0) >> 1)
+			return
+		}
+		b.name = f.Name()
+		b.err = b.flush(f)
+		return
+	}
+	f, err := os.OpenFile(b.name, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		b.err = err
+		return
+	}
+	b.err = b.flush(f)
+}
+
+// flush writes the in-memory content to f and closes it.
+func (b *spillBuffer) flush(f *os.File) error {
+	_, err := b.mem.WriteTo(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// WriteTo copies the whole content to w.
+func (b *spillBuffer) WriteTo(w io.Writer) (int64, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if b.name == "" {
+		return b.mem.WriteTo(w)
+	}
+	f, err := os.Open(b.name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(w, f)
+	_ = f.Close()
+	if err != nil {
+		return n, err
+	}
+	m, err := b.mem.WriteTo(w)
+	return n + m, err
+}
+
+// Close releases the memory and removes the temp file, if any.
+func (b *spillBuffer) Close() error {
+	b.mem = bytes.Buffer{}
+	if b.name == "" {
+		return nil
+	}
+	err := os.Remove(b.name)
+	b.name = ""
+	return err
+}
@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSpillBuffer_SpillsAndReplays(t *testing.T) {
+	b := newSpillBuffer(16)
+	fmt.Fprintf(b, "short\n")
+	if b.name != "" {
+		t.Fatal("spilled below the limit")
+	}
+	long := strings.Repeat("x", 40)
+	fmt.Fprintf(b, "%s\n", long)
+	if b.name == "" {
+		t.Fatal("expected spill to a temp file past the limit")
+	}
+	name := b.name
+	fmt.Fprintf(b, "tail\n")
+
+	var out bytes.Buffer
+	if _, err := b.WriteTo(&out); err != nil {
+		t.Fatal(err)
+	}
+	if want := "short\n" + long + "\ntail\n"; out.String() != want {
+		t.Fatalf("replayed %q, want %q", out.String(), want)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Fatalf("temp file %s still exists after Close", name)
+	}
+}
@@ -0,0 +1,48 @@
+//go:build unix
+
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestSpillBuffer_ManySpilledUnderLowFileLimit(t *testing.T) {
+	var old syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &old); err != nil {
+		t.Skip(err)
+	}
+	low := old
+	low.Cur = 64
+	if err := syscall.Setrlimit(syscall.RLIMIT_NOFILE, &low); err != nil {
+		t.Skip(err)
+	}
+	t.Cleanup(func() { _ = syscall.Setrlimit(syscall.RLIMIT_NOFILE, &old) })
+
+	bufs := make([]*spillBuffer, 4*low.Cur)
+	for i := range bufs {
+		b := newSpillBuffer(16)
+		fmt.Fprintf(b, "%d %s\n", i, strings.Repeat("x", 40))
+		fmt.Fprintf(b, "%d %s\n", i, strings.Repeat("y", 40))
+		if b.name == "" {
+			t.Fatalf("buffer %d did not spill", i)
+		}
+		bufs[i] = b
+	}
+	for i, b := range bufs {
+		var out bytes.Buffer
+		if _, err := b.WriteTo(&out); err != nil {
+			t.Fatalf("buffer %d: %v", i, err)
+		}
+		want := fmt.Sprintf("%d %s\n%d %s\n", i, strings.Repeat("x", 40), i, strings.Repeat("y", 40))
+		if out.String() != want {
+			t.Fatalf("buffer %d replayed %q, want %q", i, out.String(), want)
+		}
+		if err := b.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/report"
+)
+
+// flag columns get a distinct-value histogram in stats
+var flagColumns = map[string]struct{}{
+	"casesensitive": {},
+	"translatable":  {},
+	"forbidden":     {},
+}
+
+// computeStats gathers per-column statistics. Lengths are in runes and only
+// consider non-empty cells.
+func computeStats(data []byte) (*report.Stats, error) {
+	tbl, err := csvutil.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if tbl == nil {
+		return &report.Stats{}, nil
+	}
+
+	st := &report.Stats{Rows: len(tbl.Rows), Columns: make([]report.ColumnStats, len(tbl.Header))}
+	totals := make([]int, len(tbl.Header))
+	for i, h := range tbl.Header {
+		st.Columns[i].Name = strings.TrimSpace(h)
+		if _, ok := flagColumns[csvutil.NormalizeHeader(h)]; ok {
+			st.Columns[i].Values = map[string]int{}
+		}
+	}
+
+	for _, row := range tbl.Rows {
+		for i := range st.Columns {
+			cs := &st.Columns[i]
+			v := strings.TrimSpace(row.Get(i))
+			if cs.Values != nil {
+				cs.Values[v]++
+			}
+			if v == "" {
+				continue
+			}
+			n := utf8.RuneCountInString(v)
+			if cs.NonEmpty == 0 || n < cs.MinLen {
+				cs.MinLen = n
+			}
+			if n > cs.MaxLen {
+				cs.MaxLen = n
+			}
+			cs.NonEmpty++
+			totals[i] += n
+		}
+	}
+
+	for i := range st.Columns {
+		if st.Columns[i].NonEmpty > 0 {
+			avg := float64(totals[i]) / float64(st.Columns[i].NonEmpty)
+			st.Columns[i].AvgLen = math.Round(avg*100) / 100
+		}
+	}
+	return st, nil
+}
+
+// writeStats renders a compact per-column table for the human report.
+func writeStats(b io.Writer, st *report.Stats) {
+	fmt.Fprintf(b, "\nColumn stats (%d rows):\n", st.Rows)
+	for _, c := range st.Columns {
+		fmt.Fprintf(b, "   %-20s non-empty=%d len(min/max/avg)=%d/%d/%.2f",
+			c.Name, c.NonEmpty, c.MinLen, c.MaxLen, c.AvgLen)
+		if len(c.Values) > 0 {
+			keys := make([]string, 0, len(c.Values))
+			for k := range c.Values {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			parts := make([]string, 0, len(keys))
+			for _, k := range keys {
+				label := k
+				if label == "" {
+					label = "<empty>"
+				}
+				parts = append(parts, fmt.Sprintf("%s=%d", label, c.Values[k]))
+			}
+			fmt.Fprintf(b, " values: %s", strings.Join(parts, ", "))
+		}
+		fmt.Fprintln(b)
+	}
+}
@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// stdinArg in --files reads a glossary from standard input.
+const stdinArg = "-"
+
+var (
+	useStdin bool
+	// stdinName stands for standard input in reports, fixed copy names and
+	// the checks that look at the file name, such as the extension check.
+	stdinName = "stdin.csv"
+	// stdin is what was read from standard input; fromStdin is set once it
+	// has been.
+	stdin     []byte
+	fromStdin bool
+	// stdinReader is os.Stdin, replaced in tests.
+	stdinReader io.Reader = os.Stdin
+)
+
+// takeStdin reads standard input when paths has stdinArg, and puts
+// stdinName in its place.
+func takeStdin(paths []string) ([]string, error) {
+	i := slices.Index(paths, stdinArg)
+	if i < 0 {
+		return paths, nil
+	}
+	if slices.Contains(paths, stdinName) {
+		return nil, fmt.Errorf("--stdin-name %q is also one of the files; pick another name", stdinName)
+	}
+	data, err := io.ReadAll(stdinReader)
+	if err != nil {
+		return nil, fmt.Errorf("read standard input: %w", err)
+	}
+	stdin, fromStdin = data, true
+	paths[i] = stdinName
+	return paths, nil
+}
+
+func isStdin(path string) bool { return fromStdin && path == stdinName }
+
+// readInput returns the content of an input file, standard input or a
+// fetched URL.
+func readInput(path string) ([]byte, error) {
+	if isStdin(path) {
+		return stdin, nil
+	}
+	if r, ok := fetched[path]; ok {
+		return r.data, r.err
+	}
+	return os.ReadFile(path)
+}
+
+// openInput opens an input file, standard input or a fetched URL.
+func openInput(path string) (io.ReadCloser, error) {
+	if _, ok := fetched[path]; !ok && !isStdin(path) {
+		return os.Open(path)
+	}
+	data, err := readInput(path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
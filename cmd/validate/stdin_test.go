@@ -0,0 +1,43 @@
+package validate
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTakeStdin(t *testing.T) {
+	const csv = "term;description\nAPI;Interface\n"
+	stdinReader = strings.NewReader(csv)
+	t.Cleanup(func() {
+		stdinReader, stdin, fromStdin, stdinName = os.Stdin, nil, false, "stdin.csv"
+	})
+
+	got, err := takeStdin([]string{"a.csv", stdinArg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a.csv,stdin.csv"; strings.Join(got, ",") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+	data, err := readInput("stdin.csv")
+	if err != nil || string(data) != csv {
+		t.Errorf("readInput = %q, %v", data, err)
+	}
+	f, err := openInput("stdin.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := io.ReadAll(f); string(data) != csv {
+		t.Errorf("openInput read %q", data)
+	}
+	if _, err := readInput("a.csv"); err == nil {
+		t.Error("a.csv is not standard input")
+	}
+
+	stdinName = "a.csv"
+	if _, err := takeStdin([]string{"a.csv", stdinArg}); err == nil {
+		t.Error("a --stdin-name clashing with a file should be refused")
+	}
+}
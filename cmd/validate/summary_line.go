@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/ledger"
+)
+
+// fileTotals counts files by their overall result.
+type fileTotals struct {
+	pass, warn, fail, errored int
+}
+
+func countFiles(outcomes []fileOutcome) fileTotals {
+	var t fileTotals
+	for _, oc := range outcomes {
+		switch _, class := fileResult(oc); class {
+		case "pass":
+			t.pass++
+		case "warn":
+			t.warn++
+		case "fail":
+			t.fail++
+		default:
+			t.errored++
+		}
+	}
+	return t
+}
+
+// summaryLine renders totals as a single key=value line for shell scripts,
+// e.g. "files=12 pass=9 warn=2 fail=1 error=0 duration=3.2s".
+func summaryLine(outcomes []fileOutcome, elapsed time.Duration) string {
+	t := countFiles(outcomes)
+	return fmt.Sprintf("files=%d pass=%d warn=%d fail=%d error=%d duration=%.1fs",
+		len(outcomes), t.pass, t.warn, t.fail, t.errored, elapsed.Seconds())
+}
+
+// emitSummaryLine always prints the summary line to stderr (so it never mixes
+// with --json on stdout) and also writes it to --summary-file when given.
+func emitSummaryLine(outcomes []fileOutcome, elapsed time.Duration) error {
+	line := summaryLine(outcomes, elapsed)
+	fmt.Fprintln(os.Stderr, line)
+	if summaryFile == "" {
+		return nil
+	}
+	if err := fsutil.WriteFile(summaryFile, []byte(line+"\n"), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write summary file: %v", err)))
+		return err
+	}
+	return nil
+}
+
+// appendLedger records the run totals in --ledger for the trend command.
+func appendLedger(outcomes []fileOutcome, elapsed time.Duration) error {
+	if ledgerPath == "" {
+		return nil
+	}
+	t := countFiles(outcomes)
+	e := ledger.Entry{
+		Time:       time.Now().UTC(),
+		Commit:     ledger.CurrentCommit(),
+		Files:      len(outcomes),
+		Pass:       t.pass,
+		Warn:       t.warn,
+		Fail:       t.fail,
+		Error:      t.errored,
+		DurationMS: elapsed.Milliseconds(),
+	}
+	if err := ledger.Append(ledgerPath, e); err != nil {
+		fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to append to ledger: %v", err)))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummaryLine(t *testing.T) {
+	outcomes := []fileOutcome{
+		{Passed: 1},
+		{Passed: 1},
+		{Warned: 1},
+		{Failed: 1, HadValFail: true},
+		{HadOpErr: true, Errored: 1},
+	}
+	got := summaryLine(outcomes, 3210*time.Millisecond)
+	want := "files=5 pass=2 warn=1 fail=1 error=1 duration=3.2s"
+	if got != want {
+		t.Fatalf("summaryLine = %q, want %q", got, want)
+	}
+}
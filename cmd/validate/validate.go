@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -16,8 +20,19 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/batch"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/glob"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/ignore"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/report"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/undo"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+	guard "github.com/bodrovis/lokalise-glossary-guard/pkg/validator"
 )
 
 var (
@@ -26,10 +41,43 @@ var (
 	maxParallel uint
 	jsonOut     bool
 	noColor     bool
+	htmlReport  string
+	findingsOut string
+	withStats   bool
+	printSchema bool
+	printOrder  bool
+	sarifOut    string
+	junitOut    string
+	outputPath  string
+	summaryFile string
+	groupBy     string
+	sortBy      string
+	badgeOut    string
+	orderBy     string
+	historyFile string
+	ledgerPath  string
+	checkLinks  bool
+
+	slowThreshold time.Duration
+
+	// stdout receives the report; redirected to a file by --output.
+	stdout io.Writer = os.Stdout
 
 	doFix         bool
+	fixDryRun     bool
+	inPlace       bool
+	fixOnly       []string
+	noFix         []string
+	onlyChecks    []string
+	recursive     bool
+	excludes      []string
+	noIgnore      bool
+	skipChecks    []string
+	backupDir     string
+	fixReportOut  string
 	hardFailOnErr bool
 	rerunAfterFix bool
+	diffContext   int
 
 	clrReset  = "\x1b[0m"
 	clrRed    = "\x1b[31m"
@@ -39,16 +87,31 @@ var (
 )
 
 type fileOutcome struct {
-	Idx        int                `json:"-"`
-	Path       string             `json:"path"`
-	Output     string             `json:"-"`
-	Passed     int                `json:"passed"`
-	Warned     int                `json:"warned"`
-	Failed     int                `json:"failed"`
-	Errored    int                `json:"errored"`
-	HadOpErr   bool               `json:"had_op_err"`
-	HadValFail bool               `json:"had_val_fail"`
-	Summary    *validator.Summary `json:"summary,omitempty"`
+	Idx        int
+	Path       string
+	Output     *spillBuffer
+	Passed     int
+	Warned     int
+	Failed     int
+	Errored    int
+	HadOpErr   bool
+	HadValFail bool
+	OpError    string
+	Summary    *validator.Summary
+	Stats      *report.Stats
+	Skipped    []string
+	Duration   time.Duration
+	// CheckDurations and Findings are aligned with Summary.Outcomes.
+	CheckDurations []time.Duration
+	Findings       [][]findings.Finding
+	// Fixes is this file's --fix-report entry, if fixes were applied.
+	Fixes *fixFile
+	// Modified is set when fixes changed the file and the result was
+	// written, or would have been with --fix-dry-run.
+	Modified bool
+	// Resumed is set for files skipped by --resume; only the counts are
+	// known.
+	Resumed bool
 }
 
 type job struct {
@@ -57,8 +120,9 @@ type job struct {
 }
 
 var validateCmd = &cobra.Command{
-	Use:   "validate",
-	Short: "Validate one or multiple glossary files; optionally apply auto-fixes to _fixed copies",
+	Use:         "validate",
+	Annotations: map[string]string{config.Annotation: "true"},
+	Short:       "Validate one or multiple glossary files; optionally apply auto-fixes to _fixed copies",
 	Long: `Run all registered checks against one or multiple glossary CSV files.
 
 Examples:
@@ -68,37 +132,204 @@ Examples:
   # Validate and attempt fixes (writes glossary_fixed.csv on change)
   glossary-guard validate -f glossary.csv --fix
 
+  # Every *.csv under a directory tree, except fixed copies
+  glossary-guard validate -f ./glossaries -r --exclude "*_fixed.csv"
+
+  # Put fixed copies under out/ with their original names
+  glossary-guard validate -f "data/*.csv" --fix --fix-output-dir out --fix-suffix ""
+
+  # Fix the file itself, keeping the original as glossary.csv.bak-<timestamp>
+  glossary-guard validate -f glossary.csv --fix --in-place
+
+  # Only trust some fixers
+  glossary-guard validate -f glossary.csv --fix --fix-only no-invalid-flags,warn-cell-whitespace
+  glossary-guard validate -f glossary.csv --fix --no-fix GG-DUPLICATE-TERM
+
+  # Show what --fix would change without writing anything
+  glossary-guard validate -f glossary.csv --fix-dry-run
+
   # Multiple files + explicit languages
   glossary-guard validate -f a.csv -f b.csv -l en -l de -l fr --fix
 
   # Glob + parallel workers
   glossary-guard validate -f "data/*.csv" --parallel 8
+
+  # Generated CSV from a pipe
+  ./export.sh | glossary-guard validate -f - --stdin-name product.csv
+
+  # A glossary published by another team
+  glossary-guard validate -f https://example.com/glossaries/product.csv
+
+  # Share results as a static HTML page
+  glossary-guard validate -f "data/*.csv" --html-report report.html
 `,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if printSchema {
+			return nil
+		}
+		if printOrder {
+			if err := buildOrder(); err != nil {
+				return err
+			}
+			var err error
+			checkSelected, err = buildFilter(onlyChecks, skipChecks, "--only", "--skip")
+			return err
+		}
+		if useStdin {
+			files = append(files, stdinArg)
+		}
 		if len(files) == 0 {
-			return fmt.Errorf("no files provided; use --files to specify one or more CSV files")
+			return fmt.Errorf("no files provided; use --files to specify one or more CSV files, or --stdin")
 		}
 		if !noColor && os.Getenv("NO_COLOR") != "" {
 			noColor = true
 		}
+		if groupBy != groupByFile && groupBy != groupByCheck {
+			return fmt.Errorf("invalid --group-by %q (expected %s or %s)", groupBy, groupByFile, groupByCheck)
+		}
+		if !validSort(sortBy) {
+			return fmt.Errorf("invalid --sort %q (expected %s)", sortBy, strings.Join(sortModes, ", "))
+		}
+		if orderBy != orderPriority && orderBy != orderSmart {
+			return fmt.Errorf("invalid --order %q (expected %s or %s)", orderBy, orderPriority, orderSmart)
+		}
 		langs = preprocessLangs(langs)
+		if fixDryRun {
+			doFix = true
+		}
+		if inPlace && !doFix {
+			return fmt.Errorf("--in-place needs --fix")
+		}
+		if backupDir != "" && !inPlace {
+			return fmt.Errorf("--backup-dir needs --in-place")
+		}
+		if fixReportOut != "" && !doFix {
+			return fmt.Errorf("--fix-report needs --fix")
+		}
+		if fixSuffix == "" && fixOutputDir == "" {
+			return fmt.Errorf("--fix-suffix cannot be empty without --fix-output-dir (use --in-place to overwrite the input)")
+		}
+		if inPlace && (fixOutputDir != "" || cmd.Flags().Changed("fix-suffix")) {
+			return fmt.Errorf("--in-place cannot be combined with --fix-suffix or --fix-output-dir")
+		}
+		if failOnFix && !doFix {
+			return fmt.Errorf("--fail-on-fix needs --fix")
+		}
+		if (fixOutputDir != "" || cmd.Flags().Changed("fix-suffix")) && !doFix {
+			return fmt.Errorf("--fix-suffix and --fix-output-dir need --fix")
+		}
+		if resume && (!doFix || fixDryRun) {
+			return fmt.Errorf("--resume needs --fix without --fix-dry-run")
+		}
+		if (len(fixOnly) > 0 || len(noFix) > 0) && !doFix {
+			return fmt.Errorf("--fix-only and --no-fix need --fix")
+		}
+		readsStdin := slices.Contains(files, stdinArg)
+		if cmd.Flags().Changed("stdin-name") && !readsStdin {
+			return fmt.Errorf("--stdin-name needs --stdin or -f -")
+		}
+		if stdinName == "" || stdinName == stdinArg {
+			return fmt.Errorf("invalid --stdin-name %q", stdinName)
+		}
+		if readsStdin && inPlace {
+			return fmt.Errorf("--in-place cannot fix standard input")
+		}
+		if readsStdin && resume {
+			return fmt.Errorf("--resume cannot be combined with standard input")
+		}
+		if slices.ContainsFunc(files, isURL) && (inPlace || resume) {
+			return fmt.Errorf("--in-place and --resume cannot be used with URLs")
+		}
+		backupStamp = time.Now().Format("20060102-150405")
+		if checkLinks {
+			cfg := *config.Get()
+			cfg.Checks.URLs.CheckLinks = true
+			config.Set(&cfg)
+		}
 
 		var err error
 		files, err = expandFiles(files)
 		if err != nil {
 			return err
 		}
+		if files, err = excludeFiles(files, excludes); err != nil {
+			return err
+		}
+		if files, err = takeStdin(files); err != nil {
+			return err
+		}
+		fetchRemote(cmd.Context(), files)
 		if len(checks.List()) == 0 {
 			fmt.Fprintln(os.Stderr, red("No checks registered. Nothing to run."))
 			return fmt.Errorf("no checks to run")
 		}
-		return nil
+		if err := buildOrder(); err != nil {
+			return err
+		}
+		if checkSelected, err = buildFilter(onlyChecks, skipChecks, "--only", "--skip"); err != nil {
+			return err
+		}
+		if len(pipeline()) == 0 {
+			return fmt.Errorf("--only and --skip leave no checks to run")
+		}
+		fixAllowed, err = buildFixFilter(fixOnly, noFix)
+		return err
 	},
-	RunE: func(cmd *cobra.Command, args []string) error {
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if outputPath != "" {
+			af, oerr := fsutil.CreateAtomic(outputPath, 0o644)
+			if oerr != nil {
+				return fmt.Errorf("open output: %w", oerr)
+			}
+			stdout = af
+			noColor = true
+			defer func() {
+				if cerr := af.Commit(); cerr != nil {
+					fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write output: %v", cerr)))
+					if err == nil {
+						err = cerr
+					}
+				}
+			}()
+		}
+
+		if printSchema {
+			_, err := stdout.Write(report.Schema)
+			return err
+		}
+		if printOrder {
+			writeOrder(stdout)
+			return nil
+		}
+
+		if err := loadHistory(); err != nil {
+			return err
+		}
+		if doFix && !fixDryRun {
+			if undoDir != "" {
+				if resume {
+					if undoRun, err = undo.Continue(undoDir); err != nil {
+						return err
+					}
+				} else {
+					undoRun = undo.Begin(undoDir)
+				}
+			}
+			if progress, err = openResumeLog(resumeFile, resume); err != nil {
+				return err
+			}
+		}
+
 		start := time.Now()
 		sep := strings.Repeat("─", 72)
 
+		sinks, err := openSinks()
+		if err != nil {
+			return err
+		}
+
 		jobs := make(chan job)
+		results := make(chan fileOutcome)
 		outcomes := make([]fileOutcome, len(files))
 
 		if maxParallel < 1 {
@@ -112,17 +343,27 @@ Examples:
 
 		ctx := cmd.Context()
 		opts := buildRunOptions()
+		if len(files) > 1 {
+			batch.Set(readHeaders(files))
+		}
 
 		for w := 0; w < workers; w++ {
 			go func() {
 				defer wg.Done()
 				for j := range jobs {
-					outcomes[j.idx] = runOneFile(ctx, j.idx, j.path, langs, sep, opts)
+					if progress != nil {
+						if oc, ok := progress.finished(j.idx, j.path); ok {
+							results <- oc
+							continue
+						}
+					}
+					results <- runOneFile(ctx, j.idx, j.path, langs, sep, opts)
 				}
 			}()
 		}
 
 		go func() {
+			defer close(jobs)
 			for i, p := range files {
 				select {
 				case <-ctx.Done():
@@ -130,11 +371,67 @@ Examples:
 				case jobs <- job{idx: i, path: p}:
 				}
 			}
-			close(jobs)
 		}()
 
-		wg.Wait()
-		return finalize(outcomes, len(files), start)
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		// In input order, hand each file to the printer and streaming sinks
+		// as soon as the next expected one is done. Other orderings need
+		// the full set, so emission waits until the end.
+		em := &emitter{sinks: sinks}
+		streamInOrder := sortBy == sortInput
+		done := make([]bool, len(files))
+		next := 0
+		for oc := range results {
+			outcomes[oc.Idx] = oc
+			done[oc.Idx] = true
+			if progress != nil && ctx.Err() == nil {
+				if err := progress.record(oc); err != nil && em.err == nil {
+					em.err = err
+				}
+			}
+			if !streamInOrder {
+				continue
+			}
+			for ; next < len(done) && done[next]; next++ {
+				em.emit(&outcomes[next])
+			}
+		}
+		if !streamInOrder {
+			sortOutcomes(outcomes, sortBy)
+			for i := range outcomes {
+				if outcomes[i].Path != "" {
+					em.emit(&outcomes[i])
+				}
+			}
+		}
+		if err := closeSinks(sinks); err != nil && em.err == nil {
+			em.err = err
+		}
+		if progress != nil && ctx.Err() == nil {
+			if err := progress.close(outcomes); err != nil && em.err == nil {
+				em.err = err
+			}
+		}
+		if em.err != nil {
+			fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write report: %v", em.err)))
+			return em.err
+		}
+
+		saveHistory(outcomes)
+
+		ferr := finalize(outcomes, len(files), start)
+		elapsed := time.Since(start)
+		if err := emitSummaryLine(outcomes, elapsed); err != nil && ferr == nil {
+			ferr = err
+		}
+		if err := appendLedger(outcomes, elapsed); err != nil && ferr == nil {
+			ferr = err
+		}
+		return ferr
 	},
 }
 
@@ -144,8 +441,15 @@ func Init(root *cobra.Command) {
 		"files",
 		"f",
 		nil,
-		"Path(s) to glossary file(s) (comma-separated or repeatable, supports globs)",
+		"Path(s) or http(s) URL(s) of glossary file(s), or directories of them (comma-separated or repeatable, supports globs; ** matches any directories)",
 	)
+	validateCmd.Flags().BoolVar(&useStdin, "stdin", false, "Read a glossary from standard input (same as -f -)")
+	validateCmd.Flags().StringVar(&stdinName, "stdin-name", stdinName, "File name standard input is reported and checked as")
+	validateCmd.Flags().DurationVar(&fetchTimeout, "fetch-timeout", fetchTimeout, "Time limit for fetching each glossary given by URL")
+	validateCmd.Flags().Int64Var(&fetchMaxSize, "fetch-max-size", fetchMaxSize, "Largest glossary fetched by URL, in bytes")
+	validateCmd.Flags().StringArrayVar(&excludes, "exclude", nil, "Leave out files matching this glob (repeatable; ** matches any directories, a pattern without / matches file names anywhere)")
+	validateCmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Also validate the *.csv files in subdirectories of directories given with --files")
+	validateCmd.Flags().BoolVar(&noIgnore, "no-ignore", false, "Do not read "+ignore.File+" files")
 
 	validateCmd.Flags().UintVar(
 		&maxParallel,
@@ -164,10 +468,42 @@ func Init(root *cobra.Command) {
 
 	validateCmd.Flags().BoolVar(&noColor, "no-color", false, "Disable colored output (also honored if NO_COLOR is set)")
 	validateCmd.Flags().BoolVar(&jsonOut, "json", false, "Output results as JSON (machine-readable)")
+	validateCmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the report (text or JSON) to this file instead of stdout")
+	validateCmd.Flags().StringVar(&summaryFile, "summary-file", "", "Also write the one-line totals summary to this file")
+	validateCmd.Flags().StringVar(&ledgerPath, "ledger", "", "Append this run's totals as a JSON line to this ledger file (see the trend command)")
+	validateCmd.Flags().StringVar(&groupBy, "group-by", groupByFile, "Group the text report by \"file\" or by \"check\"")
+	validateCmd.Flags().StringVar(&sortBy, "sort", sortInput, "Order files in the report: "+strings.Join(sortModes, ", ")+" (status = worst first, duration = slowest first)")
+	validateCmd.Flags().StringVar(&orderBy, "order", orderPriority, "Check order: \"priority\", or \"smart\" to run non-critical checks that failed most often on a file first (uses run history)")
+	validateCmd.Flags().StringVar(&historyFile, "history-file", "", "Where --order smart keeps run history (default: user cache dir)")
+	validateCmd.Flags().DurationVar(&slowThreshold, "slow-threshold", 0, "Mark checks that take at least this long (e.g. 500ms) as slow in the text report")
+	validateCmd.Flags().BoolVar(&printSchema, "print-schema", false, "Print the JSON Schema of the --json output and exit")
+	validateCmd.Flags().BoolVar(&printOrder, "print-order", false, "Print the order checks and their fixes run in, with their dependencies, and exit")
+	validateCmd.Flags().StringVar(&findingsOut, "findings-out", "", "Write every finding as a row to this CSV file (.tsv extension switches to tab-separated)")
+	validateCmd.Flags().BoolVar(&withStats, "stats", false, "Include per-column statistics in the report")
+	validateCmd.Flags().StringVar(&sarifOut, "sarif", "", "Stream results as a SARIF 2.1.0 log to this path")
+	validateCmd.Flags().StringVar(&junitOut, "junit", "", "Stream results as JUnit XML to this path")
+	validateCmd.Flags().StringVar(&badgeOut, "badge", "", "Write a shields.io endpoint JSON badge (e.g. badge.json)")
+	validateCmd.Flags().StringVar(&htmlReport, "html-report", "", "Also write a self-contained HTML report to this path")
 
-	validateCmd.Flags().BoolVar(&doFix, "fix", false, "Attempt auto-fixes (writes *_fixed.csv on change)")
+	validateCmd.Flags().BoolVar(&checkLinks, "check-links", false, "Request every URL in descriptions and report broken links (needs network access)")
+	validateCmd.Flags().BoolVar(&doFix, "fix", false, "Attempt auto-fixes (writes *_fixed.csv on change; see --fix-suffix and --fix-output-dir)")
+	validateCmd.Flags().StringVar(&fixSuffix, "fix-suffix", fixSuffix, "Suffix added before the extension of fixed copies (may be empty with --fix-output-dir)")
+	validateCmd.Flags().StringVar(&fixOutputDir, "fix-output-dir", "", "Write fixed copies under this directory, keeping their path relative to the working directory")
+	validateCmd.Flags().StringVar(&undoDir, "undo-dir", undo.DefaultDir, "Where --fix keeps the journal undo-fix restores from (empty disables it)")
+	validateCmd.Flags().BoolVar(&resume, "resume", false, "With --fix, skip the files an interrupted run with the same fix options already finished")
+	validateCmd.Flags().StringVar(&resumeFile, "resume-file", defaultResumeFile, "Where --fix records finished files for --resume")
+	validateCmd.Flags().BoolVar(&inPlace, "in-place", false, "With --fix, overwrite the original file instead of writing a fixed copy, after backing it up as <file>.bak-<timestamp>")
+	validateCmd.Flags().StringVar(&backupDir, "backup-dir", "", "Put the backups made by --in-place in this directory instead of next to each file")
+	validateCmd.Flags().StringSliceVar(&onlyChecks, "only", nil, "Run only these checks (names, GG- codes or globs such as \"GG-HEADER*\", comma-separated or repeatable)")
+	validateCmd.Flags().StringSliceVar(&skipChecks, "skip", nil, "Do not run these checks (names, GG- codes or globs, comma-separated or repeatable)")
+	validateCmd.Flags().StringSliceVar(&fixOnly, "fix-only", nil, "With --fix, let only these checks fix (names or GG- codes, comma-separated or repeatable); the rest only validate")
+	validateCmd.Flags().StringSliceVar(&noFix, "no-fix", nil, "With --fix, keep these checks from fixing (names or GG- codes, comma-separated or repeatable)")
+	validateCmd.Flags().StringVar(&fixReportOut, "fix-report", "", "With --fix, write a JSON report of every applied fix (check, changed lines, before/after, output path) to this file")
+	validateCmd.Flags().BoolVar(&fixDryRun, "fix-dry-run", false, "Compute auto-fixes and show what they would change without writing any file")
+	validateCmd.Flags().BoolVar(&failOnFix, "fail-on-fix", false, fmt.Sprintf("Exit with status %d when all files pass after fixing but some were modified", ExitFixed))
 	validateCmd.Flags().BoolVar(&hardFailOnErr, "hard-fail-on-error", false, "Exit non-zero when any check returns ERROR")
 	validateCmd.Flags().BoolVar(&rerunAfterFix, "rerun-after-fix", true, "Re-run validation after a successful fix")
+	validateCmd.Flags().IntVar(&diffContext, "diff-context", 3, "Unchanged lines shown around each change in the diff printed after --fix (negative disables the diff)")
 
 	root.AddCommand(validateCmd)
 }
@@ -207,32 +543,71 @@ func preprocessLangs(ls []string) []string {
 	return out
 }
 
-func expandFiles(fs []string) ([]string, error) {
+// expandFiles resolves --files into paths. Files found through globs and
+// directories are subject to the ignore files; files named explicitly
+// always run.
+func expandFiles(patterns []string) ([]string, error) {
 	seen := map[string]struct{}{}
 	var out []string
 
-	for _, f := range fs {
+	var ign *ignore.Matcher
+	if !noIgnore {
+		var err error
+		if ign, err = ignore.New("."); err != nil {
+			return nil, err
+		}
+	}
+	add := func(m string) error {
+		if _, ok := seen[m]; ok {
+			return nil
+		}
+		if ign != nil {
+			skip, err := ign.Ignored(m)
+			if err != nil {
+				return err
+			}
+			if skip {
+				return nil
+			}
+		}
+		seen[m] = struct{}{}
+		out = append(out, m)
+		return nil
+	}
+
+	for _, f := range patterns {
 		for _, raw := range strings.Split(f, ",") {
 			p := strings.TrimSpace(raw)
 			if p == "" {
 				continue
 			}
-			if hasGlob(p) {
-				matches, err := filepath.Glob(p)
+			if hasGlob(p) && !isURL(p) {
+				matches, err := glob.Expand(p)
 				if err != nil {
 					return nil, err
 				}
 				for _, m := range matches {
 					info, err := os.Stat(m)
 					if err == nil && !info.IsDir() {
-						if _, ok := seen[m]; !ok {
-							seen[m] = struct{}{}
-							out = append(out, m)
+						if err := add(m); err != nil {
+							return nil, err
 						}
 					}
 				}
 				continue
 			}
+			if info, err := os.Stat(p); err == nil && info.IsDir() {
+				found, err := csvFilesIn(p, recursive)
+				if err != nil {
+					return nil, err
+				}
+				for _, m := range found {
+					if err := add(m); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
 			if _, ok := seen[p]; ok {
 				continue
 			}
@@ -246,104 +621,223 @@ func expandFiles(fs []string) ([]string, error) {
 	return out, nil
 }
 
+// excludeFiles drops the paths matching any of patterns. Paths are matched
+// cleaned and slash-separated, absolute ones also relative to the working
+// directory; a pattern without a slash is matched against the file name.
+func excludeFiles(paths, patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		return paths, nil
+	}
+	for _, pat := range patterns {
+		if err := glob.Validate(pat); err != nil {
+			return nil, fmt.Errorf("--exclude %q: %w", pat, err)
+		}
+	}
+	wd, _ := os.Getwd()
+	excluded := func(p string) bool {
+		names := []string{filepath.ToSlash(filepath.Clean(p))}
+		if filepath.IsAbs(p) && wd != "" {
+			if rel, err := filepath.Rel(wd, p); err == nil {
+				names = append(names, filepath.ToSlash(rel))
+			}
+		}
+		for _, pat := range patterns {
+			pat = strings.TrimPrefix(filepath.ToSlash(pat), "./")
+			for _, n := range names {
+				if !strings.Contains(pat, "/") {
+					n = path.Base(n)
+				}
+				if glob.Match(pat, n) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	out := slices.DeleteFunc(paths, excluded)
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no files left after --exclude")
+	}
+	return out, nil
+}
+
+// csvFilesIn lists the *.csv files of dir in lexical order, and with
+// recursive those of its subdirectories too. Hidden directories such as
+// .git are not entered.
+func csvFilesIn(dir string, recursive bool) ([]string, error) {
+	var out []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != dir && (!recursive || strings.HasPrefix(d.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.EqualFold(filepath.Ext(p), ".csv") {
+			out = append(out, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", dir, err)
+	}
+	return out, nil
+}
+
+// readHeaders reads the header row of each file for the checks that compare
+// files. Files that cannot be read or have no header are left out; their
+// own run reports why.
+func readHeaders(paths []string) []batch.File {
+	var out []batch.File
+	for _, p := range paths {
+		f, err := openInput(p)
+		if err != nil {
+			continue
+		}
+		h, err := csvutil.ReadHeader(f)
+		_ = f.Close()
+		if err == nil && h != nil {
+			out = append(out, batch.File{Path: p, Header: h})
+		}
+	}
+	return out
+}
+
 func hasGlob(s string) bool { return strings.ContainsAny(s, "*?[]") }
 
 func finalize(outcomes []fileOutcome, filesCount int, start time.Time) error {
+	if htmlReport != "" {
+		if err := writeHTMLReport(htmlReport, outcomes, time.Since(start)); err != nil {
+			fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write html report: %v", err)))
+			return err
+		}
+	}
+	if findingsOut != "" {
+		if err := writeFindings(findingsOut, collectFindings(outcomes)); err != nil {
+			fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write findings: %v", err)))
+			return err
+		}
+	}
+	if fixReportOut != "" {
+		if err := writeFixReport(fixReportOut, outcomes); err != nil {
+			fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write fix report: %v", err)))
+			return err
+		}
+	}
+	if badgeOut != "" {
+		if err := writeBadge(badgeOut, outcomes); err != nil {
+			fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to write badge: %v", err)))
+			return err
+		}
+	}
+
 	if jsonOut {
-		enc := json.NewEncoder(os.Stdout)
+		enc := json.NewEncoder(stdout)
 		enc.SetIndent("", "  ")
-		if err := enc.Encode(outcomes); err != nil {
+		if err := enc.Encode(buildReport(outcomes)); err != nil {
 			fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to encode json: %v", err)))
 			return err
 		}
 		return aggregateReturnCode(outcomes)
 	}
 
-	hadOpErr, hadValFail, filesPassed, filesFailed, filesErrored := printAndAggregate(outcomes, filesCount, start)
-	if hadOpErr {
-		return fmt.Errorf("one or more files could not be validated due to an error")
-	}
-	if hadValFail {
-		return fmt.Errorf("validation failed")
+	if groupBy == groupByCheck {
+		writeGroupedByCheck(stdout, outcomes, strings.Repeat("─", 72))
 	}
 
-	_ = filesPassed
-	_ = filesFailed
-	_ = filesErrored
-	return nil
+	printAndAggregate(outcomes, filesCount, start)
+	return aggregateReturnCode(outcomes)
 }
 
-func printAndAggregate(outcomes []fileOutcome, filesCount int, start time.Time) (hadOpErr, hadValFail bool, filesPassed, filesFailed, filesErrored int) {
-	var totalWarns int
+func printAndAggregate(outcomes []fileOutcome, filesCount int, start time.Time) {
+	var filesPassed, filesFailed, filesErrored, totalWarns int
 
 	for _, oc := range outcomes {
-		if oc.Output != "" {
-			fmt.Print(oc.Output)
-		}
 		filesPassed += oc.Passed
 		filesFailed += oc.Failed
 		filesErrored += oc.Errored
 		if oc.Summary != nil {
 			totalWarns += oc.Summary.Warn
 		}
-		hadOpErr = hadOpErr || oc.HadOpErr
-		hadValFail = hadValFail || oc.HadValFail
 	}
 
 	if filesCount > 1 {
-		fmt.Println()
-		fmt.Printf("Overall: %s passed, %s warning(s), %s failed, %s error(s)\n",
+		fmt.Fprintln(stdout)
+		fmt.Fprintf(stdout, "Overall: %s passed, %s warning(s), %s failed, %s error(s)\n",
 			green(fmt.Sprint(filesPassed)),
 			yellow(fmt.Sprint(totalWarns)),
 			red(fmt.Sprint(filesFailed)),
 			red(fmt.Sprint(filesErrored)),
 		)
 	}
-	fmt.Printf("\nTotal time: %v\n", time.Since(start).Round(time.Millisecond))
-	return hadOpErr, hadValFail, filesPassed, filesFailed, filesErrored
+	fmt.Fprintf(stdout, "\nTotal time: %v\n", time.Since(start).Round(time.Millisecond))
 }
 
-func aggregateReturnCode(outcomes []fileOutcome) error {
-	var hadOpErr, hadValFail bool
-	for _, oc := range outcomes {
-		hadOpErr = hadOpErr || oc.HadOpErr
-		hadValFail = hadValFail || oc.HadValFail
-	}
-	if hadOpErr {
-		return fmt.Errorf("one or more files could not be validated due to an error")
+// emitter prints the rendered text of finished files (unless JSON is
+// requested), frees their buffers and feeds the streaming sinks.
+type emitter struct {
+	sinks   []fileSink
+	printed int
+	err     error
+}
+
+func (e *emitter) emit(oc *fileOutcome) {
+	if oc.Output != nil {
+		if !jsonOut && groupBy == groupByFile {
+			if e.printed > 0 {
+				fmt.Fprintln(stdout)
+			}
+			if _, err := oc.Output.WriteTo(stdout); err != nil {
+				fmt.Fprintln(os.Stderr, red(fmt.Sprintf("failed to print report for %s: %v", oc.Path, err)))
+			}
+			e.printed++
+		}
+		_ = oc.Output.Close()
+		oc.Output = nil
 	}
-	if hadValFail {
-		return fmt.Errorf("validation failed")
+
+	if len(e.sinks) > 0 {
+		rf := toReportFile(*oc)
+		for _, s := range e.sinks {
+			if err := s.WriteFile(rf); err != nil && e.err == nil {
+				e.err = err
+			}
+		}
 	}
-	return nil
 }
 
-func runOneFile(ctx context.Context, i int, path string, langs []string, sep string, opts checks.RunOptions) fileOutcome {
-	var b strings.Builder
-	if i > 0 {
-		b.WriteByte('\n')
-	}
-	fmt.Fprintf(&b, "%s\n%s: %s\n%s\n\n", sep, cyan("Validating"), path, sep)
+func runOneFile(ctx context.Context, i int, path string, langs []string, sep string, opts checks.RunOptions) (oc fileOutcome) {
+	started := time.Now()
+	b := newSpillBuffer(spillThreshold)
+	fmt.Fprintf(b, "%s\n%s: %s\n%s\n\n", sep, cyan("Validating"), path, sep)
 
-	fmt.Fprintf(&b, "Mode: FixMode=%v, RerunAfterFix=%v, HardFailOnErr=%v\n\n",
+	fmt.Fprintf(b, "Mode: FixMode=%v, RerunAfterFix=%v, HardFailOnErr=%v\n\n",
 		opts.FixMode, opts.RerunAfterFix, opts.HardFailOnErr)
 
-	oc := fileOutcome{Idx: i, Path: path}
+	oc = fileOutcome{Idx: i, Path: path, Output: b}
+	defer func() { oc.Duration = time.Since(started) }()
 
-	data, err := os.ReadFile(path)
+	data, err := readInput(path)
 	if err != nil {
-		fmt.Fprintf(&b, "%s: %v\n%s\n", red("ERROR"), err, sep)
+		fmt.Fprintf(b, "%s: %v\n%s\n", red("ERROR"), err, sep)
 		oc.HadOpErr = true
+		oc.OpError = err.Error()
 		oc.Errored++
-		oc.Output = b.String()
 		return oc
 	}
 
-	sum, verr := validator.Validate(ctx, path, data, langs, opts)
+	rep, fixed, verr := guard.ValidateAndFixUnits(ctx, guard.Source{Path: sourcePath(path), Data: data, Langs: langs}, opts, unitsFor(path))
+	sum := rep.Summary
 	oc.Summary = &sum
+	oc.Skipped = rep.Skipped
+	oc.CheckDurations = rep.Durations
+	oc.Findings = rep.Findings
 
 	// print check-by-check
-	for _, o := range sum.Outcomes {
+	for i, o := range sum.Outcomes {
 		tag := "NORM"
 		if cu, ok := checks.Lookup(o.Result.Name); ok && cu.FailFast() {
 			tag = "CRIT"
@@ -352,6 +846,9 @@ func runOneFile(ctx context.Context, i int, path string, langs []string, sep str
 		if o.Final.DidChange {
 			changed = " [changed]"
 		}
+		if i < len(rep.Durations) && slowThreshold > 0 && rep.Durations[i] >= slowThreshold {
+			changed += yellow(fmt.Sprintf(" [slow: %s]", roundDuration(rep.Durations[i])))
+		}
 
 		msg := oneLine(strings.TrimSpace(o.Result.Message))
 		if msg == "" {
@@ -362,11 +859,26 @@ func runOneFile(ctx context.Context, i int, path string, langs []string, sep str
 			msg = msg + " | note: " + note
 		}
 
-		fmt.Fprintf(&b, "→ [%s] %s ... %s%s\n", tag, o.Result.Name, colorStatus(string(o.Result.Status)), changed)
-		fmt.Fprintf(&b, "   %s\n", msg)
+		name := o.Result.Name
+		if code := checkmeta.CodeFor(name); code != "" && o.Result.Status != checks.Pass {
+			name += " (" + code + ")"
+		}
+		fmt.Fprintf(b, "→ [%s] %s ... %s%s\n", tag, name, colorStatus(string(o.Result.Status)), changed)
+		fmt.Fprintf(b, "   %s\n", msg)
+		if i < len(rep.Findings) {
+			printFindings(b, o.Result.Status, rep.Findings[i])
+		}
+		if o.Result.Status != checks.Pass {
+			if rem := checkmeta.RemediationFor(o.Result.Name).String(); rem != "" {
+				fmt.Fprintf(b, "   %s %s\n", cyan("hint:"), rem)
+			}
+			if docs := checkmeta.DocsURLFor(o.Result.Name); docs != "" {
+				fmt.Fprintf(b, "   %s %s\n", cyan("docs:"), docs)
+			}
+		}
 	}
 
-	fmt.Fprintf(&b, "\nSummary for %s: %s passed, %s warning(s), %s failed, %s errors\n",
+	fmt.Fprintf(b, "\nSummary for %s: %s passed, %s warning(s), %s failed, %s errors\n",
 		path,
 		green(fmt.Sprint(sum.Pass)),
 		yellow(fmt.Sprint(sum.Warn)),
@@ -374,54 +886,137 @@ func runOneFile(ctx context.Context, i int, path string, langs []string, sep str
 		red(fmt.Sprint(sum.Error)),
 	)
 
-	if sum.EarlyExit {
-		total := len(checks.List())
-		skipped := 0
-		if total > len(sum.Outcomes) {
-			skipped = total - len(sum.Outcomes)
+	if withStats {
+		st, err := computeStats(fixed.Data)
+		if err != nil {
+			fmt.Fprintf(b, "%s computing column stats: %v\n", yellow("WARN"), err)
+		} else {
+			oc.Stats = st
+			writeStats(b, st)
 		}
-		fmt.Fprintf(&b, "%s due to fail-fast in check %q (%s). Skipped %d remaining check(s).\n",
-			red("Stopped early"),
-			sum.EarlyCheck, string(sum.EarlyStatus), skipped)
 	}
 
-	// write *_fixed if we applied fixes
-	if opts.FixMode != checks.FixNone && sum.AppliedFixes {
-		outPath := withFixedPostfix(sum.FinalPath)
-		if writeErr := os.WriteFile(outPath, sum.FinalData, 0o644); writeErr != nil {
-			fmt.Fprintf(&b, "%s writing fixed file: %v\n", red("ERROR"), writeErr)
-			oc.HadOpErr = true
-			oc.Errored++
-		} else {
-			fmt.Fprintf(&b, "%s wrote fixed file: %s (bytes=%d)\n", cyan("Info"), outPath, len(sum.FinalData))
+	if sum.EarlyExit {
+		fmt.Fprintf(b, "%s due to fail-fast in check %q (%s). Skipped %d remaining check(s)",
+			red("Stopped early"),
+			sum.EarlyCheck, string(sum.EarlyStatus), len(rep.Skipped))
+		if len(rep.Skipped) > 0 {
+			fmt.Fprintf(b, ": %s", strings.Join(rep.Skipped, ", "))
 		}
+		fmt.Fprintln(b, ".")
+	}
+
+	// write the fixed copy if we applied fixes
+	if opts.FixMode != checks.FixNone && fixed.Changed {
+		writeFixed(b, &oc, path, data, fixed, rep)
 	}
 
 	// overall result per file
 	if sum.Fail > 0 || sum.Error > 0 || (verr != nil && !errors.Is(verr, context.Canceled)) {
-		fmt.Fprintln(&b, red("Result: FAILED"))
+		fmt.Fprintln(b, red("Result: FAILED"))
 		oc.Failed++
 		oc.HadValFail = true
 	} else if sum.Warn > 0 {
-		fmt.Fprintln(&b, yellow("Result: PASSED WITH WARNINGS"))
+		fmt.Fprintln(b, yellow("Result: PASSED WITH WARNINGS"))
 		oc.Warned++
 	} else {
-		fmt.Fprintln(&b, green("Result: PASSED"))
+		fmt.Fprintln(b, green("Result: PASSED"))
 		oc.Passed++
 	}
 
-	fmt.Fprintf(&b, "%s\n", sep)
-	oc.Output = b.String()
+	fmt.Fprintf(b, "%s\n", sep)
 	return oc
 }
 
-func withFixedPostfix(p string) string {
-	ext := filepath.Ext(p)
-	base := strings.TrimSuffix(p, ext)
-	if strings.HasSuffix(base, "_fixed") {
-		return base + ext
+// writeFixed writes the fixed content of path to its fixed copy, or over
+// the file with --in-place, and prints the diff; with --fix-dry-run it only
+// prints. Write errors are recorded on oc, and so is the --fix-report entry.
+func writeFixed(b io.Writer, oc *fileOutcome, path string, orig []byte, fixed guard.FixedContent, rep guard.Report) {
+	outPath := fixedPath(fixed.Path)
+	if inPlace {
+		outPath = path
+	}
+	var bak string
+	var err error
+	switch {
+	case fixDryRun:
+		fmt.Fprintf(b, "%s dry run, would write fixed file: %s (%s)\n", cyan("Info"), outPath, fixSummary(rep.Summary, orig, fixed.Data))
+	case inPlace:
+		if bak, err = writeInPlace(path, orig, fixed.Data); err != nil {
+			err = fmt.Errorf("fixing in place: %w", err)
+		} else {
+			fmt.Fprintf(b, "%s fixed in place: %s (bytes=%d, backup: %s)\n", cyan("Info"), path, len(fixed.Data), bak)
+			if undoRun != nil {
+				if uerr := undoRun.RecordBackup(path, bak, orig, fixed.Data); uerr != nil {
+					err = fmt.Errorf("recording undo journal: %w", uerr)
+				}
+			}
+		}
+	default:
+		if undoRun != nil {
+			if err = undoRun.Record(outPath, fixed.Data); err != nil {
+				err = fmt.Errorf("recording undo journal: %w", err)
+				break
+			}
+		}
+		if err = fsutil.WriteFile(outPath, fixed.Data, 0o644); err != nil {
+			err = fmt.Errorf("writing fixed file: %w", err)
+		} else {
+			fmt.Fprintf(b, "%s wrote fixed file: %s (bytes=%d)\n", cyan("Info"), outPath, len(fixed.Data))
+		}
+	}
+
+	if fixReportOut != "" {
+		ff := buildFixFile(path, orig, rep)
+		ff.Output, ff.Backup = outPath, bak
+		if err != nil {
+			ff.Error = err.Error()
+		}
+		oc.Fixes = &ff
+	}
+	if err != nil {
+		fmt.Fprintf(b, "%s %v\n", red("ERROR"), err)
+		oc.HadOpErr = true
+		oc.OpError = err.Error()
+		oc.Errored++
+		return
+	}
+	if diffContext >= 0 {
+		from := path
+		if bak != "" {
+			from = bak
+		}
+		writeFixDiff(b, from, outPath, orig, fixed.Data, diffContext)
+	}
+	oc.Modified = true
+}
+
+// maxListedFindings caps the findings printed per check in text output;
+// JSON, SARIF and --findings-out carry all of them.
+const maxListedFindings = 10
+
+// printFindings lists the findings of a check, one per line. A finding whose
+// severity differs from the check status is tagged with it.
+func printFindings(b io.Writer, status checks.Status, fds []findings.Finding) {
+	for i, f := range fds {
+		if i == maxListedFindings {
+			fmt.Fprintf(b, "   - ... and %d more\n", len(fds)-i)
+			return
+		}
+		tag := ""
+		if f.Severity != status {
+			tag = "[" + colorStatus(string(f.Severity)) + "] "
+		}
+		fmt.Fprintf(b, "   - %s%s\n", tag, f)
+	}
+}
+
+// roundDuration keeps sub-millisecond timings readable.
+func roundDuration(d time.Duration) time.Duration {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond)
 	}
-	return base + "_fixed" + ext
+	return d.Round(time.Millisecond)
 }
 
 func green(s string) string {
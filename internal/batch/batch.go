@@ -0,0 +1,47 @@
+// Package batch describes all files of a run to checks that compare a file
+// with the others. Checks otherwise see one file at a time.
+package batch
+
+import (
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// File is an input file and its header row.
+type File struct {
+	Path   string
+	Header []string
+}
+
+var current atomic.Pointer[[]File]
+
+// Set records the files of the run, in input order.
+func Set(fs []File) {
+	current.Store(&fs)
+}
+
+// Files returns the files of the run, or nil when a single file is checked.
+func Files() []File {
+	if fs := current.Load(); fs != nil {
+		return *fs
+	}
+	return nil
+}
+
+// Index returns the position of path among fs, or -1. Fixes may change a
+// file's extension, so a path matching without its extension counts too.
+func Index(fs []File, path string) int {
+	for i, f := range fs {
+		if f.Path == path {
+			return i
+		}
+	}
+	stem := strings.TrimSuffix(path, filepath.Ext(path))
+	for i, f := range fs {
+		if strings.TrimSuffix(f.Path, filepath.Ext(f.Path)) == stem {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,114 @@
+// Package catexport converts a glossary into the term base formats read by
+// CAT tools: memoQ and Trados MultiTerm CSV, and TBX-Basic. The glossary is
+// taken in the shape of the Lokalise API terms, so the same parsed file
+// feeds both an upload and an export.
+package catexport
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+)
+
+// Export formats.
+const (
+	MemoQ  = "memoq"
+	Trados = "trados"
+	TBX    = "tbx"
+)
+
+// Formats lists the supported formats.
+var Formats = []string{MemoQ, Trados, TBX}
+
+// TermBase is a glossary ready for export. The term column is written as
+// the source language; every other language column becomes a target.
+type TermBase struct {
+	Source  string   // BCP 47 code of the source language, e.g. "en"
+	Targets []string // BCP 47 codes of the target languages, in column order
+	Terms   []lokalise.Term
+}
+
+// New builds a term base from parsed terms. Language codes are converted
+// from the Lokalise form (de_DE) to BCP 47 (de-DE); the source language
+// column, if the glossary has one, is not repeated as a target.
+func New(source string, terms []lokalise.Term, locales []lokalise.LocaleMapping) TermBase {
+	tb := TermBase{Source: bcp47(source), Terms: terms}
+	for _, l := range locales {
+		code := bcp47(l.Lokalise)
+		if !strings.EqualFold(code, tb.Source) && !slices.Contains(tb.Targets, code) {
+			tb.Targets = append(tb.Targets, code)
+		}
+	}
+	return tb
+}
+
+// Write encodes tb in format to w.
+func Write(w io.Writer, format string, tb TermBase) error {
+	switch format {
+	case MemoQ:
+		return writeMemoQ(w, tb)
+	case Trados:
+		return writeTrados(w, tb)
+	case TBX:
+		return writeTBX(w, tb)
+	default:
+		return fmt.Errorf("unknown format %q (expected %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// Ext returns the file extension conventionally used for format.
+func Ext(format string) string {
+	if format == TBX {
+		return ".tbx"
+	}
+	return ".csv"
+}
+
+// translation returns the value and language description of a term in the
+// target language code.
+func translation(t lokalise.Term, code string) (value, desc string) {
+	for _, tr := range t.Translations {
+		if strings.EqualFold(bcp47(tr.LangISO), code) {
+			return tr.Translation, tr.Description
+		}
+	}
+	return "", ""
+}
+
+func bcp47(code string) string {
+	return strings.ReplaceAll(strings.TrimSpace(code), "_", "-")
+}
+
+// langName returns the English name of a language code in the given style:
+// memoQ joins language and region with "_" and spaces as "_"
+// (English_United_States), MultiTerm uses "English (United States)".
+// Unknown codes are returned as is.
+func langName(code string, memoQ bool) string {
+	tag, err := language.Parse(code)
+	if err != nil {
+		return code
+	}
+	base, _ := tag.Base()
+	name := display.English.Languages().Name(language.Make(base.String()))
+	if name == "" {
+		return code
+	}
+	region, conf := tag.Region()
+	if conf != language.Exact {
+		if memoQ {
+			return strings.ReplaceAll(name, " ", "_")
+		}
+		return name
+	}
+	rname := display.English.Regions().Name(region)
+	if memoQ {
+		return strings.ReplaceAll(name+" "+rname, " ", "_")
+	}
+	return name + " (" + rname + ")"
+}
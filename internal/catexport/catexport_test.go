@@ -0,0 +1,88 @@
+package catexport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+)
+
+const glossary = "term;description;casesensitive;translatable;forbidden;tags;en;de_DE;de_DE_description;fr\n" +
+	"cart;basket;no;yes;no;shop, ui;cart;Warenkorb;Einkaufswagen;panier\n" +
+	"checkout page;old name;no;yes;yes;;;;;\n"
+
+func termBase(t *testing.T) TermBase {
+	t.Helper()
+	tbl, err := csvutil.Parse([]byte(glossary))
+	if err != nil || tbl == nil {
+		t.Fatalf("parse: %v", err)
+	}
+	terms, locales, _, err := lokalise.TermsFromTable(tbl, nil, lokalise.KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New("en", terms, locales)
+}
+
+func export(t *testing.T, format string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Write(&buf, format, termBase(t)); err != nil {
+		t.Fatalf("%s: %v", format, err)
+	}
+	return buf.String()
+}
+
+func TestNew_SkipsSourceColumn(t *testing.T) {
+	tb := termBase(t)
+	if tb.Source != "en" || strings.Join(tb.Targets, ",") != "de-DE,fr" {
+		t.Fatalf("unexpected languages: %q -> %q", tb.Source, tb.Targets)
+	}
+}
+
+func TestWrite_MemoQ(t *testing.T) {
+	want := "\ufeffEntry_ID,Entry_Domain,Entry_Note,English,English_Def,German_Germany,German_Germany_Def,French,French_Def\r\n" +
+		"1,\"shop, ui\",basket,cart,,Warenkorb,Einkaufswagen,panier,\r\n" +
+		"2,,old name,checkout page,,,,,\r\n"
+	if got := export(t, MemoQ); got != want {
+		t.Fatalf("got:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestWrite_Trados(t *testing.T) {
+	want := "\ufeffEnglish,German (Germany),French,Definition,Subject,Status\r\n" +
+		"cart,Warenkorb,panier,basket,\"shop, ui\",preferred\r\n" +
+		"checkout page,,,old name,,forbidden\r\n"
+	if got := export(t, Trados); got != want {
+		t.Fatalf("got:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestWrite_TBX(t *testing.T) {
+	got := export(t, TBX)
+	for _, want := range []string{
+		`<martif type="TBX-Basic" xml:lang="en">`,
+		`<termEntry id="c1">`,
+		`<descrip type="subjectField">shop, ui</descrip>`,
+		`<langSet xml:lang="de-DE">`,
+		`<descrip type="definition">Einkaufswagen</descrip>`,
+		`<term>Warenkorb</term>`,
+		`<termNote type="administrativeStatus">deprecatedTerm-admn-sts</termNote>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %s in:\n%s", want, got)
+		}
+	}
+	// untranslated languages get no langSet
+	if strings.Count(got, "<langSet") != 4 {
+		t.Errorf("want 4 langSets (3 for c1, 1 for c2), got:\n%s", got)
+	}
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, "xliff", termBase(t)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,77 @@
+package catexport
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// bom makes Windows CAT tools pick UTF-8 instead of the ANSI code page.
+const bom = "\ufeff"
+
+// writeMemoQ writes the column layout of a memoQ term base CSV export:
+// entry fields first, then a term and a definition column per language
+// (English_United_States, English_United_States_Def, ...). memoQ maps the
+// columns to languages by these names on import. Term status has no CSV
+// column in memoQ; use TBX to carry forbidden terms.
+func writeMemoQ(w io.Writer, tb TermBase) error {
+	header := []string{"Entry_ID", "Entry_Domain", "Entry_Note"}
+	for _, code := range append([]string{tb.Source}, tb.Targets...) {
+		name := langName(code, true)
+		header = append(header, name, name+"_Def")
+	}
+
+	return writeCSV(w, header, len(tb.Terms), func(i int) []string {
+		t := tb.Terms[i]
+		rec := []string{strconv.Itoa(i + 1), strings.Join(t.Tags, ", "), t.Description, t.Term, ""}
+		for _, code := range tb.Targets {
+			v, d := translation(t, code)
+			rec = append(rec, v, d)
+		}
+		return rec
+	})
+}
+
+// writeTrados writes a CSV in the shape MultiTerm (via Glossary Converter)
+// expects: one column per language named "English (United States)", then
+// descriptive fields. Forbidden terms are marked in the Status field.
+func writeTrados(w io.Writer, tb TermBase) error {
+	header := []string{langName(tb.Source, false)}
+	for _, code := range tb.Targets {
+		header = append(header, langName(code, false))
+	}
+	header = append(header, "Definition", "Subject", "Status")
+
+	return writeCSV(w, header, len(tb.Terms), func(i int) []string {
+		t := tb.Terms[i]
+		rec := []string{t.Term}
+		for _, code := range tb.Targets {
+			v, _ := translation(t, code)
+			rec = append(rec, v)
+		}
+		status := "preferred"
+		if t.Forbidden {
+			status = "forbidden"
+		}
+		return append(rec, t.Description, strings.Join(t.Tags, ", "), status)
+	})
+}
+
+func writeCSV(w io.Writer, header []string, n int, record func(i int) []string) error {
+	if _, err := io.WriteString(w, bom); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for i := range n {
+		if err := cw.Write(record(i)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,99 @@
+package catexport
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TBX-Basic (ISO 30042) document, limited to the data categories a Lokalise
+// glossary has: definitions, subject field (tags) and administrative status
+// (forbidden terms are deprecated).
+type martif struct {
+	XMLName xml.Name `xml:"martif"`
+	Type    string   `xml:"type,attr"`
+	Lang    string   `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Header  struct {
+		Source string `xml:"fileDesc>sourceDesc>p"`
+		XCS    struct {
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"encodingDesc>p"`
+	} `xml:"martifHeader"`
+	Entries []termEntry `xml:"text>body>termEntry"`
+}
+
+type termEntry struct {
+	ID      string    `xml:"id,attr"`
+	Descrip []descrip `xml:"descrip"`
+	LangSet []langSet `xml:"langSet"`
+}
+
+type descrip struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type langSet struct {
+	Lang    string    `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Descrip []descrip `xml:"descrip"`
+	Tig     tig       `xml:"tig"`
+}
+
+type tig struct {
+	Term     string     `xml:"term"`
+	TermNote []termNote `xml:"termNote"`
+}
+
+type termNote struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func writeTBX(w io.Writer, tb TermBase) error {
+	doc := martif{Type: "TBX-Basic", Lang: tb.Source}
+	doc.Header.Source = "Exported by lokalise-glossary-guard"
+	doc.Header.XCS.Type = "XCSURI"
+	doc.Header.XCS.Value = "TBXBasicXCSV02.xcs"
+
+	for i, t := range tb.Terms {
+		e := termEntry{ID: "c" + strconv.Itoa(i+1)}
+		if t.Description != "" {
+			e.Descrip = append(e.Descrip, descrip{Type: "definition", Value: t.Description})
+		}
+		if len(t.Tags) > 0 {
+			e.Descrip = append(e.Descrip, descrip{Type: "subjectField", Value: strings.Join(t.Tags, ", ")})
+		}
+
+		src := langSet{Lang: tb.Source, Tig: tig{Term: t.Term}}
+		if t.Forbidden {
+			src.Tig.TermNote = []termNote{{Type: "administrativeStatus", Value: "deprecatedTerm-admn-sts"}}
+		}
+		e.LangSet = append(e.LangSet, src)
+
+		for _, code := range tb.Targets {
+			v, d := translation(t, code)
+			if v == "" {
+				continue
+			}
+			ls := langSet{Lang: code, Tig: tig{Term: v}}
+			if d != "" {
+				ls.Descrip = []descrip{{Type: "definition", Value: d}}
+			}
+			e.LangSet = append(e.LangSet, ls)
+		}
+		doc.Entries = append(doc.Entries, e)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
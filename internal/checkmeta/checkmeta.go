@@ -0,0 +1,116 @@
+// Package checkmeta holds per-check metadata that the core result model does
+// not carry, such as stable rule codes and remediation hints. Core checks
+// are described in core.go; checks living in this repository register their
+// own entry from init, next to checks.Register.
+package checkmeta
+
+import (
+	"regexp"
+	"sync"
+)
+
+// DocsBase is the page remediation links point into.
+const DocsBase = "https://github.com/bodrovis/lokalise-glossary-guard"
+
+// RulesDocsBase holds one page per rule code, docs/rules/<code>.md.
+const RulesDocsBase = DocsBase + "/blob/main/docs/rules/"
+
+// Remediation tells the user what to do about a non-passing check.
+type Remediation struct {
+	Hint string // short actionable instruction
+	Link string // optional documentation link
+}
+
+// Meta is everything known about a check beyond its name and priority.
+type Meta struct {
+	// Code is the stable rule id (GG-UTF8, GG-HEADER, ...). Unlike the
+	// check name it never changes, so reports, baselines and suppressions
+	// should refer to it.
+	Code        string
+	Remediation Remediation
+	// After names the checks whose fixes must run before this check's fix,
+	// because it works on what they repair: cell-level fixers need
+	// semicolon-separated input, for example. Priorities already keep
+	// these; they guard configured orders and reordering.
+	After []string
+}
+
+// codePattern is the shape every rule code must have.
+var codePattern = regexp.MustCompile(`^GG-[A-Z0-9]+(-[A-Z0-9]+)*$`)
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Meta{}
+	codes    = map[string]string{} // code -> check name
+)
+
+// Register sets the metadata for a check, replacing any previous entry. It
+// panics, like checks.Register failures in init, when the code is missing,
+// malformed or already used by another check.
+func Register(name string, m Meta) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !codePattern.MatchString(m.Code) {
+		panic("checkmeta: check " + name + " has invalid rule code " + `"` + m.Code + `"`)
+	}
+	if other, ok := codes[m.Code]; ok && other != name {
+		panic("checkmeta: rule code " + m.Code + " used by both " + other + " and " + name)
+	}
+	if old, ok := registry[name]; ok {
+		delete(codes, old.Code)
+	}
+	registry[name] = m
+	codes[m.Code] = name
+}
+
+// Lookup returns the metadata registered for a check.
+func Lookup(name string) (Meta, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	m, ok := registry[name]
+	return m, ok
+}
+
+// DocsURL returns the page explaining the rule and how to fix it, or ""
+// when the check has no code.
+func (m Meta) DocsURL() string {
+	if m.Code == "" {
+		return ""
+	}
+	return RulesDocsBase + m.Code + ".md"
+}
+
+// DocsURLFor returns the rule page of a check, or "" for unknown checks.
+func DocsURLFor(name string) string {
+	m, _ := Lookup(name)
+	return m.DocsURL()
+}
+
+// CodeFor returns the rule code of a check, or "" for unknown checks.
+func CodeFor(name string) string {
+	m, _ := Lookup(name)
+	return m.Code
+}
+
+// AfterFor returns the checks that must run before a check, or nil.
+func AfterFor(name string) []string {
+	m, _ := Lookup(name)
+	return m.After
+}
+
+// RemediationFor returns the remediation for a check, or the zero value.
+func RemediationFor(name string) Remediation {
+	m, _ := Lookup(name)
+	return m.Remediation
+}
+
+// String renders the hint with its link in parentheses, if any.
+func (r Remediation) String() string {
+	if r.Link == "" {
+		return r.Hint
+	}
+	if r.Hint == "" {
+		return r.Link
+	}
+	return r.Hint + " (" + r.Link + ")"
+}
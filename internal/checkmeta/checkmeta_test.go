@@ -0,0 +1,56 @@
+package checkmeta
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
+)
+
+func TestCoreChecksHaveRemediation(t *testing.T) {
+	for _, u := range checks.ListSorted() {
+		if RemediationFor(u.Name()).Hint == "" {
+			t.Errorf("no remediation hint for core check %q", u.Name())
+		}
+	}
+}
+
+func TestRemediationString(t *testing.T) {
+	cases := []struct {
+		r    Remediation
+		want string
+	}{
+		{Remediation{}, ""},
+		{Remediation{Hint: "do it"}, "do it"},
+		{Remediation{Link: "https://x"}, "https://x"},
+		{Remediation{Hint: "do it", Link: "https://x"}, "do it (https://x)"},
+	}
+	for _, tc := range cases {
+		if got := tc.r.String(); got != tc.want {
+			t.Errorf("%+v: got %q, want %q", tc.r, got, tc.want)
+		}
+	}
+}
+
+func TestRegister_RejectsBadCodes(t *testing.T) {
+	mustPanic := func(name string, m Meta) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Register(%q, %q) did not panic", name, m.Code)
+			}
+		}()
+		Register(name, m)
+	}
+	mustPanic("test-no-code", Meta{})
+	mustPanic("test-lowercase", Meta{Code: "GG-utf8"})
+	mustPanic("test-taken", Meta{Code: CodeFor("ensure-utf8-encoding")})
+
+	// re-registering a check may keep or change its own code
+	Register("test-own", Meta{Code: "GG-TEST-OWN"})
+	Register("test-own", Meta{Code: "GG-TEST-OWN-2"})
+	Register("test-other", Meta{Code: "GG-TEST-OWN"})
+	if CodeFor("test-own") != "GG-TEST-OWN-2" {
+		t.Errorf("unexpected code %q", CodeFor("test-own"))
+	}
+}
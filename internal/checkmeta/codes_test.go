@@ -0,0 +1,52 @@
+package checkmeta_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/all"
+)
+
+// Every check that can run, core or ours, must have a rule code and a page
+// under docs/rules for its DocsURL to point at.
+func TestAllChecksHaveCodesAndDocs(t *testing.T) {
+	for _, u := range checks.ListSorted() {
+		code := checkmeta.CodeFor(u.Name())
+		if code == "" {
+			t.Errorf("no rule code for check %q", u.Name())
+			continue
+		}
+		if _, err := os.Stat(filepath.Join("..", "..", "docs", "rules", code+".md")); err != nil {
+			t.Errorf("no docs page for %s (%s): %v", code, u.Name(), err)
+		}
+		if want := checkmeta.RulesDocsBase + code + ".md"; checkmeta.DocsURLFor(u.Name()) != want {
+			t.Errorf("%s: DocsURL %q, want %q", u.Name(), checkmeta.DocsURLFor(u.Name()), want)
+		}
+	}
+}
+
+// Declared dependencies must name real checks and hold in priority order,
+// so they only ever matter for configured orders and reordering.
+func TestAfterHoldsInPriorityOrder(t *testing.T) {
+	pos := map[string]int{}
+	for i, u := range checks.ListSorted() {
+		pos[u.Name()] = i
+	}
+	for _, u := range checks.ListSorted() {
+		for _, dep := range checkmeta.AfterFor(u.Name()) {
+			j, ok := pos[dep]
+			if !ok {
+				t.Errorf("%s: runs after unknown check %q", u.Name(), dep)
+				continue
+			}
+			if j > pos[u.Name()] {
+				t.Errorf("%s runs before %s, which it declares to run after", u.Name(), dep)
+			}
+		}
+	}
+}
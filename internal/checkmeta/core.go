@@ -0,0 +1,74 @@
+package checkmeta
+
+const (
+	formattingDocs = DocsBase + "#general-formatting-rules"
+	columnsDocs    = DocsBase + "#column-structure"
+	notesDocs      = DocsBase + "#notes"
+)
+
+// Metadata for the checks shipped with the core library.
+func init() {
+	for name, m := range map[string]Meta{
+		"ensure-valid-extension": {Code: "GG-EXTENSION", Remediation: Remediation{
+			Hint: "Save the glossary as a .csv file.",
+			Link: formattingDocs,
+		}},
+		"ensure-utf8-encoding": {Code: "GG-UTF8", Remediation: Remediation{
+			Hint: "Re-save the file as UTF-8 (\"CSV UTF-8\" in Excel), or run with --fix to transcode UTF-16/32, Windows-1252 and ISO-8859-1.",
+			Link: formattingDocs,
+		}},
+		"ensure-no-empty-lines": {Code: "GG-EMPTY-LINE", Remediation: Remediation{
+			Hint: "Delete blank lines between rows, or run with --fix.",
+			Link: notesDocs,
+		}},
+		"ensure-not-empty": {Code: "GG-EMPTY-FILE", Remediation: Remediation{
+			Hint: "Export the glossary again; the file has no content.",
+		}},
+		"ensure-at-least-two-lines": {Code: "GG-MIN-LINES", Remediation: Remediation{
+			Hint: "Add a header row followed by at least one term row.",
+			Link: formattingDocs,
+		}},
+		"ensure-semicolon-separators": {Code: "GG-SEPARATOR", Remediation: Remediation{
+			Hint: "Use semicolons between columns; when exporting from a spreadsheet pick \";\" as the delimiter.",
+			Link: formattingDocs,
+		}},
+		"no-spaces-in-header": {Code: "GG-HEADER-SPACES", Remediation: Remediation{
+			Hint: "Remove spaces around header names, e.g. \" term\" -> \"term\".",
+			Link: columnsDocs,
+		}},
+		"ensure-lowercase-header": {Code: "GG-HEADER-CASE", Remediation: Remediation{
+			Hint: "Write service columns in lowercase (term, description, casesensitive, ...).",
+			Link: columnsDocs,
+		}},
+		"ensure-term-description-header": {Code: "GG-HEADER", Remediation: Remediation{
+			Hint: "Make term and description the first two columns, or run with --fix to reorder them.",
+			Link: columnsDocs,
+		}},
+		"ensure-allowed-columns-header": {Code: "GG-HEADER-COLUMNS", Remediation: Remediation{
+			Hint: "Rename or remove unknown columns; language columns must match the codes passed via --langs.",
+			Link: columnsDocs,
+		}},
+		"warn-duplicate-header-cells": {Code: "GG-HEADER-DUPLICATE", Remediation: Remediation{
+			Hint: "Give each column a unique name; merge or delete the repeated one.",
+			Link: notesDocs,
+		}},
+		"no-empty-term-values": {Code: "GG-EMPTY-TERM", Remediation: Remediation{
+			Hint: "Fill in the term for every row or delete rows without one.",
+			Link: columnsDocs,
+		}},
+		"warn-duplicate-term-values": {Code: "GG-DUPLICATE-TERM", Remediation: Remediation{
+			Hint: "Keep one row per term, or run with --fix to merge the repeats (see the strategy setting).",
+			Link: notesDocs,
+		}, After: []string{"ensure-semicolon-separators", "warn-duplicate-rows"}},
+		"warn-orphan-locale-descriptions": {Code: "GG-ORPHAN-DESCRIPTION", Remediation: Remediation{
+			Hint: "Add the matching language column for each <lang>_description column, or remove the description column.",
+			Link: columnsDocs,
+		}},
+		"no-invalid-flags": {Code: "GG-FLAGS", Remediation: Remediation{
+			Hint: "Use only yes or no in casesensitive, translatable and forbidden, or run with --fix to normalize.",
+			Link: columnsDocs,
+		}},
+	} {
+		Register(name, m)
+	}
+}
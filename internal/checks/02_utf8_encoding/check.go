@@ -0,0 +1,171 @@
+// Package utf8_encoding replaces the core ensure-utf8-encoding check with one
+// that names the encoding a file is actually in and transcodes it to UTF-8
+// on --fix.
+package utf8_encoding
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	// The core check must register first so that the one below replaces it.
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/2_valid_encoding"
+)
+
+const checkName = "ensure-utf8-encoding"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runUTF8Encoding,
+		checks.WithFailFast(),
+		checks.WithPriority(2),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+}
+
+func runUTF8Encoding(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateUTF8Encoding,
+		Fix:              fixUTF8Encoding,
+		PassMsg:          "file encoding is valid UTF-8",
+		FixedMsg:         "file transcoded to UTF-8",
+		AppliedMsg:       "auto-fix applied: file transcoded to UTF-8",
+		StatusAfterFixed: checks.Pass,
+	})
+}
+
+// source is the encoding a file was detected in.
+type source struct {
+	name string
+	enc  encoding.Encoding // nil for UTF-8
+}
+
+// detect guesses the encoding of data: a UTF-32 or UTF-16 BOM, UTF-16
+// without BOM (every other byte zero), UTF-8, and otherwise a single-byte
+// Western encoding. Bytes 0x80-0x9F are printable in Windows-1252 but
+// control characters in ISO-8859-1, so they decide between the two.
+func detect(data []byte) source {
+	switch {
+	case bytes.HasPrefix(data, []byte{0x00, 0x00, 0xFE, 0xFF}):
+		return source{"UTF-32BE", utf32.UTF32(utf32.BigEndian, utf32.ExpectBOM)}
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE, 0x00, 0x00}):
+		return source{"UTF-32LE", utf32.UTF32(utf32.LittleEndian, utf32.ExpectBOM)}
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		return source{"UTF-16BE", unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM)}
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		return source{"UTF-16LE", unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM)}
+	}
+	if bigEndian, ok := utf16WithoutBOM(data); ok {
+		if bigEndian {
+			return source{"UTF-16BE without BOM", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)}
+		}
+		return source{"UTF-16LE without BOM", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)}
+	}
+	if utf8.Valid(data) {
+		return source{name: "UTF-8"}
+	}
+	for _, b := range data {
+		if b >= 0x80 && b <= 0x9F {
+			return source{"Windows-1252", charmap.Windows1252}
+		}
+	}
+	return source{"ISO-8859-1", charmap.ISO8859_1}
+}
+
+// utf16WithoutBOM reports whether data looks like UTF-16 text of mostly
+// Latin characters: at least a fifth of the first 4 KiB are zero bytes,
+// nearly all at even (big-endian) or odd (little-endian) offsets.
+func utf16WithoutBOM(data []byte) (bigEndian, ok bool) {
+	probe := data[:min(len(data), 4096)]
+	if len(probe) < 4 {
+		return false, false
+	}
+	var even, odd int
+	for i, b := range probe {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			even++
+		} else {
+			odd++
+		}
+	}
+	if (even+odd)*5 < len(probe) {
+		return false, false
+	}
+	switch {
+	case even > odd*2:
+		return true, true
+	case odd > even*2:
+		return false, true
+	}
+	return false, false
+}
+
+func validateUTF8Encoding(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(a.Data) == 0 {
+		return checks.ValidationResult{OK: false, Msg: "empty file: cannot determine encoding (expected UTF-8)"}
+	}
+
+	src := detect(a.Data)
+	if src.enc == nil {
+		return checks.ValidationResult{OK: true, Msg: "valid UTF-8"}
+	}
+	msg := fmt.Sprintf("file is %s, not UTF-8", src.name)
+	if _, singleByte := src.enc.(*charmap.Charmap); singleByte {
+		msg += fmt.Sprintf(" (first invalid byte at offset %d)", firstInvalid(a.Data))
+	}
+	return checks.ValidationResult{OK: false, Msg: msg}
+}
+
+// firstInvalid returns the offset of the first byte of data that does not
+// start a valid UTF-8 sequence.
+func firstInvalid(data []byte) int {
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		i += size
+	}
+	return len(data)
+}
+
+// fixUTF8Encoding transcodes the file from its detected encoding to UTF-8
+// without BOM; the note names the source encoding.
+func fixUTF8Encoding(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	src := detect(a.Data)
+	if src.enc == nil {
+		return checks.FixResult{Data: a.Data, Note: "already valid UTF-8"}, nil
+	}
+	out, err := src.enc.NewDecoder().Bytes(a.Data)
+	if err != nil {
+		return checks.FixResult{}, fmt.Errorf("decode %s: %w", src.name, err)
+	}
+	out = bytes.TrimPrefix(out, []byte("\ufeff"))
+	if !utf8.Valid(out) {
+		return checks.FixResult{}, fmt.Errorf("decode %s: result is not valid UTF-8", src.name)
+	}
+	return checks.FixResult{Data: out, DidChange: true, Note: "transcoded from " + src.name + " to UTF-8"}, nil
+}
@@ -0,0 +1,87 @@
+package utf8_encoding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const text = "term;description;de\ncafé;„Kaffee“ bar;Café\n"
+
+func TestRegisteredOverCore(t *testing.T) {
+	c, ok := checks.Lookup(checkName)
+	if !ok || !c.FailFast() || c.Priority() != 2 {
+		t.Fatalf("check not registered as expected: %v", ok)
+	}
+	out := c.Run(context.Background(), checks.Artifact{Data: []byte{0x41, 0x93, 0x42}}, checks.RunOptions{})
+	if out.Result.Message != "file is Windows-1252, not UTF-8 (first invalid byte at offset 1)" {
+		t.Fatalf("core check still registered: %q", out.Result.Message)
+	}
+}
+
+func TestRunUTF8Encoding(t *testing.T) {
+	utf16 := func(bigEndian, bom bool) []byte {
+		var out []byte
+		if bom {
+			out = []byte{0xFF, 0xFE}
+			if bigEndian {
+				out = []byte{0xFE, 0xFF}
+			}
+		}
+		for _, r := range text {
+			hi, lo := byte(r>>8), byte(r)
+			if bigEndian {
+				out = append(out, hi, lo)
+			} else {
+				out = append(out, lo, hi)
+			}
+		}
+		return out
+	}
+	utf32le := []byte{0xFF, 0xFE, 0x00, 0x00}
+	for _, r := range text {
+		utf32le = append(utf32le, byte(r), byte(r>>8), byte(r>>16), 0)
+	}
+
+	cases := []struct {
+		name  string
+		data  []byte
+		msg   string // before fixing
+		note  string
+		fixed string
+	}{
+		{"windows-1252", []byte("term;description;de\ncaf\xe9;\x84Kaffee\x93 bar;Caf\xe9\n"),
+			"file is Windows-1252, not UTF-8 (first invalid byte at offset 23)", "transcoded from Windows-1252 to UTF-8", text},
+		{"iso-8859-1", []byte("term;description\ncaf\xe9;Kaffee\n"),
+			"file is ISO-8859-1, not UTF-8 (first invalid byte at offset 20)", "transcoded from ISO-8859-1 to UTF-8", "term;description\ncafé;Kaffee\n"},
+		{"utf-16le", utf16(false, true), "file is UTF-16LE, not UTF-8", "transcoded from UTF-16LE to UTF-8", text},
+		{"utf-16be", utf16(true, true), "file is UTF-16BE, not UTF-8", "transcoded from UTF-16BE to UTF-8", text},
+		{"utf-16le without BOM", utf16(false, false), "file is UTF-16LE without BOM, not UTF-8", "transcoded from UTF-16LE without BOM to UTF-8", text},
+		{"utf-32le", utf32le, "file is UTF-32LE, not UTF-8", "transcoded from UTF-32LE to UTF-8", text},
+	}
+	for _, tc := range cases {
+		a := checks.Artifact{Data: tc.data, Path: "g.csv"}
+		out := runUTF8Encoding(context.Background(), a, checks.RunOptions{})
+		if out.Result.Status != checks.Fail || out.Result.Message != tc.msg {
+			t.Errorf("%s: got %s %q, want FAIL %q", tc.name, out.Result.Status, out.Result.Message, tc.msg)
+		}
+		out = runUTF8Encoding(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed, RerunAfterFix: true})
+		if out.Result.Status != checks.Pass || !out.Final.DidChange || out.Final.Note != tc.note || string(out.Final.Data) != tc.fixed {
+			t.Errorf("%s: fix got %s %q note %q\n%q", tc.name, out.Result.Status, out.Result.Message, out.Final.Note, out.Final.Data)
+		}
+	}
+}
+
+func TestRunUTF8EncodingValid(t *testing.T) {
+	for _, data := range []string{text, "\ufeff" + text} {
+		out := runUTF8Encoding(context.Background(), checks.Artifact{Data: []byte(data)}, checks.RunOptions{FixMode: checks.FixAlways})
+		if out.Result.Status != checks.Pass || out.Final.DidChange {
+			t.Errorf("got %s %q changed=%v", out.Result.Status, out.Result.Message, out.Final.DidChange)
+		}
+	}
+	out := runUTF8Encoding(context.Background(), checks.Artifact{}, checks.RunOptions{})
+	if out.Result.Status != checks.Fail {
+		t.Errorf("empty file: got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
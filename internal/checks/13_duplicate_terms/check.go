@@ -0,0 +1,259 @@
+// Package duplicate_terms replaces the core warn-duplicate-term-values
+// check with one whose --fix merges the rows of a repeated term by a
+// configurable strategy and says what each merge kept and dropped.
+package duplicate_terms
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	// The core check must register first so that the one below replaces it.
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/13_no_duplicate_term_values"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-duplicate-term-values"
+
+// Merge strategies besides the default keep-first.
+const (
+	strategyLongestDesc = "keep-longest-description"
+	strategyUnion       = "union-translations"
+)
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runDuplicateTerms,
+		checks.WithPriority(13),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+}
+
+func runDuplicateTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateDuplicateTerms,
+		Fix:              fixDuplicateTerms,
+		PassMsg:          "no duplicate term values",
+		FixedMsg:         "merged duplicate term rows",
+		AppliedMsg:       "auto-fix applied: merged duplicate term rows",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "duplicate term values are still present after fix",
+	})
+}
+
+// group is a term and the rows it appears on, in file order.
+type group struct {
+	term string
+	rows []csvutil.Row
+}
+
+// duplicates returns the terms appearing on more than one row, ordered by
+// their first row. Terms are trimmed and compared case-sensitively.
+func duplicates(tbl *csvutil.Table, termCol int) []group {
+	idx := map[string]int{}
+	var all []group
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		if term == "" {
+			continue
+		}
+		i, ok := idx[term]
+		if !ok {
+			i = len(all)
+			idx[term] = i
+			all = append(all, group{term: term})
+		}
+		all[i].rows = append(all[i].rows, row)
+	}
+	var out []group
+	for _, g := range all {
+		if len(g.rows) > 1 {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// validateDuplicateTerms warns when the same non-empty term appears on
+// several rows: the later ones overwrite the earlier on upload. "Apple" and
+// "apple" are different terms.
+func validateDuplicateTerms(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for duplicate term values"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no header line found (nothing to validate for duplicate term values)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping duplicate term check)"}
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+
+	var bad []string
+	var fds []findings.Finding
+	for _, g := range duplicates(tbl, termCol) {
+		bad = append(bad, fmt.Sprintf("%q (rows %s)", g.term, lines(g.rows)))
+		for _, row := range g.rows[1:] {
+			fds = append(fds, findings.At(row.Line, termName, row.Offset, fmt.Sprintf("%q repeats row %d", g.term, g.rows[0].Line)))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no duplicate term values"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "duplicate term values found: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// fixDuplicateTerms leaves one row per term, chosen or merged by the
+// configured strategy, and describes every merge in the note.
+func fixDuplicateTerms(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.NoFix(a, "no parsable header")
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.NoFix(a, "no 'term' column found")
+	}
+	groups := duplicates(tbl, termCol)
+	if len(groups) == 0 {
+		return checks.NoFix(a, "no duplicate term rows to merge")
+	}
+
+	strategy := config.Get().Checks.DuplicateTerms.Strategy
+	descCol := tbl.Col("description")
+	replace := map[int][]string{} // kept line -> merged cells
+	drop := map[int]bool{}
+	var notes []string
+	for _, g := range groups {
+		var kept csvutil.Row
+		var note string
+		switch strategy {
+		case strategyLongestDesc:
+			kept = g.rows[0]
+			for _, row := range g.rows[1:] {
+				if descLen(row, descCol) > descLen(kept, descCol) {
+					kept = row
+				}
+			}
+			note = fmt.Sprintf("%q: kept row %d (longest description), dropped %s", g.term, kept.Line, rowsExcept(g.rows, kept.Line))
+		case strategyUnion:
+			kept = g.rows[0]
+			cells, conflicts := union(tbl.Header, g.rows, termCol)
+			replace[kept.Line] = cells
+			note = fmt.Sprintf("%q: merged %s into row %d", g.term, rowsExcept(g.rows, kept.Line), kept.Line)
+			if len(conflicts) > 0 {
+				note += " (" + strings.Join(conflicts, ", ") + ")"
+			}
+		default: // keep-first
+			kept = g.rows[0]
+			note = fmt.Sprintf("%q: kept row %d, dropped %s", g.term, kept.Line, rowsExcept(g.rows, kept.Line))
+		}
+		for _, row := range g.rows {
+			if row.Line != kept.Line {
+				drop[row.Line] = true
+			}
+		}
+		notes = append(notes, note)
+	}
+
+	out, _, err := csvutil.Replace(a.Data, func(line int, rec []string) ([]string, bool) {
+		if drop[line] {
+			return nil, true
+		}
+		cells, ok := replace[line]
+		return cells, ok
+	})
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	return checks.FixResult{
+		Data:      out,
+		DidChange: true,
+		Note:      "merged duplicate terms: " + csvutil.JoinLimited(notes, "; ", 10),
+	}, nil
+}
+
+// union fills the empty cells of the first row from the later ones. Where
+// two rows hold different values the first one is kept, and the dropped
+// value is listed in conflicts so the note shows it.
+func union(header []string, rows []csvutil.Row, termCol int) (cells []string, conflicts []string) {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row.Cells))
+	}
+	cells = make([]string, width)
+	copy(cells, rows[0].Cells)
+	for _, row := range rows[1:] {
+		for c, v := range row.Cells {
+			if c == termCol || strings.TrimSpace(v) == "" || v == cells[c] {
+				continue
+			}
+			if strings.TrimSpace(cells[c]) == "" {
+				cells[c] = v
+				continue
+			}
+			name := "column " + strconv.Itoa(c+1)
+			if c < len(header) {
+				name = strings.TrimSpace(header[c])
+			}
+			conflicts = append(conflicts, fmt.Sprintf("%s: kept %q, dropped %q from row %d", name, cells[c], v, row.Line))
+		}
+	}
+	return cells, conflicts
+}
+
+func descLen(row csvutil.Row, descCol int) int {
+	return utf8.RuneCountInString(strings.TrimSpace(row.Get(descCol)))
+}
+
+// lines lists the lines of rows: "2, 5".
+func lines(rows []csvutil.Row) string {
+	ls := make([]string, len(rows))
+	for i, row := range rows {
+		ls[i] = strconv.Itoa(row.Line)
+	}
+	return strings.Join(ls, ", ")
+}
+
+// rowsExcept names the rows of rows other than line: "row 5", "rows 5, 9".
+func rowsExcept(rows []csvutil.Row, line int) string {
+	var rest []csvutil.Row
+	for _, row := range rows {
+		if row.Line != line {
+			rest = append(rest, row)
+		}
+	}
+	if len(rest) == 1 {
+		return "row " + lines(rest)
+	}
+	return "rows " + lines(rest)
+}
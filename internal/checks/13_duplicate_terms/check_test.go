@@ -0,0 +1,57 @@
+package duplicate_terms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+const data = "term;description;de;fr\n" +
+	"file;a file;Datei;\n" +
+	"folder;d;Ordner;dossier\n" +
+	"file;a file on disk;Akte;fichier\n"
+
+func TestValidateDuplicateTerms(t *testing.T) {
+	res := validateDuplicateTerms(context.Background(), checks.Artifact{Data: []byte(data)})
+	want := `duplicate term values found: "file" (rows 2, 4) (total 1)`
+	if res.OK || res.Msg != want {
+		t.Fatalf("got %v %q, want %q", res.OK, res.Msg, want)
+	}
+}
+
+func TestFixDuplicateTerms(t *testing.T) {
+	t.Cleanup(func() { config.Set(nil) })
+	cases := []struct {
+		strategy, want, note string
+	}{
+		{
+			"",
+			"term;description;de;fr\nfile;a file;Datei;\nfolder;d;Ordner;dossier\n",
+			`merged duplicate terms: "file": kept row 2, dropped row 4 (total 1)`,
+		},
+		{
+			"keep-longest-description",
+			"term;description;de;fr\nfolder;d;Ordner;dossier\nfile;a file on disk;Akte;fichier\n",
+			`merged duplicate terms: "file": kept row 4 (longest description), dropped row 2 (total 1)`,
+		},
+		{
+			"union-translations",
+			"term;description;de;fr\nfile;a file;Datei;fichier\nfolder;d;Ordner;dossier\n",
+			`merged duplicate terms: "file": merged row 4 into row 2 (description: kept "a file", dropped "a file on disk" from row 4, de: kept "Datei", dropped "Akte" from row 4) (total 1)`,
+		},
+	}
+	for _, c := range cases {
+		config.Set(&config.Config{Checks: config.Checks{DuplicateTerms: config.DuplicateTerms{Strategy: c.strategy}}})
+		out := runDuplicateTerms(context.Background(), checks.Artifact{Data: []byte(data), Path: "g.csv"},
+			checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+		if out.Result.Status != checks.Pass || string(out.Final.Data) != c.want {
+			t.Errorf("%q: got %s %q\n%q", c.strategy, out.Result.Status, out.Result.Message, out.Final.Data)
+		}
+		if out.Final.Note != c.note {
+			t.Errorf("%q: note %q\nwant %q", c.strategy, out.Final.Note, c.note)
+		}
+	}
+}
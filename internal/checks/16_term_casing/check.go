@@ -0,0 +1,213 @@
+package term_casing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-term-casing"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runTermCasing,
+		checks.WithPriority(16),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-TERM-CASING", Remediation: checkmeta.Remediation{
+		Hint: "Adjust the term casing to the configured policy, or list the term under exceptions.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runTermCasing(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateTermCasing,
+		Fix:      nil,
+		FailAs:   checks.Warn,
+	})
+}
+
+func validateTermCasing(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	cfg := config.Get().Checks.TermCasing
+	if cfg.Policy == "" {
+		return checks.ValidationResult{OK: true, Msg: "no term casing policy configured (skipping)"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for term casing"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping term casing)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping term casing)"}
+	}
+
+	exceptions := make(map[string]struct{}, len(cfg.Exceptions))
+	for _, e := range cfg.Exceptions {
+		exceptions[strings.TrimSpace(e)] = struct{}{}
+	}
+
+	csCol, trCol := tbl.Col("casesensitive"), tbl.Col("translatable")
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		if term == "" {
+			continue
+		}
+		if cfg.Policy == "lowercase-unless-proper-noun" && isName(row, csCol, trCol) {
+			continue
+		}
+		if !conforms(cfg.Policy, term, exceptions) {
+			bad = append(bad, fmt.Sprintf("%q (row %d)", term, row.Line))
+			msg := fmt.Sprintf("%q violates the %s policy", term, cfg.Policy)
+			if cfg.Policy != "no-all-caps" {
+				msg += fmt.Sprintf(" (expected %q)", expected(cfg.Policy, term, exceptions))
+			}
+			fds = append(fds, findings.At(row.Line, strings.TrimSpace(tbl.Header[termCol]), row.Offset, msg))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "all terms follow the " + cfg.Policy + " policy"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms violating " + cfg.Policy + " policy: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// minorWords stay lowercase inside title-case terms.
+var minorWords = map[string]struct{}{
+	"a": {}, "an": {}, "the": {}, "and": {}, "but": {}, "or": {}, "nor": {},
+	"as": {}, "at": {}, "by": {}, "for": {}, "from": {}, "in": {}, "into": {},
+	"of": {}, "off": {}, "on": {}, "onto": {}, "per": {}, "to": {}, "up": {},
+	"via": {}, "vs": {}, "with": {},
+}
+
+// particles are minor words that are capitalized when they belong to a verb
+// ("Log In", "Sign Up"). That cannot be told from the text, so title case
+// accepts them either way.
+var particles = map[string]struct{}{"in": {}, "off": {}, "on": {}, "up": {}}
+
+// conforms reports whether term follows policy. Words listed in exceptions
+// (proper nouns, acronyms) are never judged.
+func conforms(policy, term string, exceptions map[string]struct{}) bool {
+	if policy == "no-all-caps" {
+		if _, ok := exceptions[term]; ok {
+			return true
+		}
+		var judged strings.Builder
+		for _, w := range strings.Fields(term) {
+			if !isException(w, exceptions) {
+				judged.WriteString(w)
+			}
+		}
+		return !isAllCaps(judged.String())
+	}
+	want := strings.Fields(expected(policy, term, exceptions))
+	for i, w := range strings.Fields(term) {
+		if w == want[i] {
+			continue
+		}
+		if _, ok := particles[want[i]]; !ok || policy != "title-case" || w != upperFirst(want[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// expected returns term recased to follow policy, keeping exceptions as
+// written. Title case capitalizes every word but minor ones ("Save As", "Terms
+// of Use"); lowercase-unless-proper-noun lowercases like lowercase, and the
+// caller skips terms the glossary marks as names.
+func expected(policy, term string, exceptions map[string]struct{}) string {
+	if _, ok := exceptions[term]; ok {
+		return term
+	}
+	words := strings.Fields(term)
+	for i, w := range words {
+		if isException(w, exceptions) {
+			continue
+		}
+		want := strings.ToLower(w)
+		switch policy {
+		case "sentence-case":
+			if i == 0 {
+				want = upperFirst(want)
+			}
+		case "title-case":
+			_, minor := minorWords[strings.Trim(want, ".,:;!?()\"'")]
+			if i == 0 || i == len(words)-1 || !minor {
+				want = upperFirst(want)
+			}
+		}
+		words[i] = want
+	}
+	return strings.Join(words, " ")
+}
+
+func isException(w string, exceptions map[string]struct{}) bool {
+	_, ok := exceptions[strings.Trim(w, ".,:;!?()\"'")]
+	return ok
+}
+
+// isName reports whether the glossary marks the row's term as a proper
+// noun: case-sensitive, or not to be translated.
+func isName(row csvutil.Row, csCol, trCol int) bool {
+	flag := func(c int) string {
+		if c < 0 {
+			return ""
+		}
+		return strings.ToLower(strings.TrimSpace(row.Get(c)))
+	}
+	return flag(csCol) == "yes" || flag(trCol) == "no"
+}
+
+func upperFirst(s string) string {
+	for i, r := range s {
+		if unicode.IsLetter(r) {
+			return s[:i] + string(unicode.ToUpper(r)) + s[i+len(string(r)):]
+		}
+	}
+	return s
+}
+
+// isAllCaps is true when s has at least two letters and none of them is lowercase.
+func isAllCaps(s string) bool {
+	letters := 0
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if unicode.IsLower(r) {
+			return false
+		}
+		letters++
+	}
+	return letters >= 2
+}
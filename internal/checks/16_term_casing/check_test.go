@@ -0,0 +1,89 @@
+package term_casing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestConforms(t *testing.T) {
+	exc := map[string]struct{}{"Lokalise": {}, "API": {}}
+	cases := []struct {
+		policy string
+		term   string
+		want   bool
+	}{
+		{"lowercase", "log in", true},
+		{"lowercase", "Log in", false},
+		{"lowercase", "Lokalise project", true},
+		{"sentence-case", "Log in", true},
+		{"sentence-case", "log in", false},
+		{"sentence-case", "Open Lokalise", true},
+		{"sentence-case", "Open File", false},
+		{"no-all-caps", "SAVE FILE", false},
+		{"no-all-caps", "API", true},
+		{"no-all-caps", "API key", true},
+		{"no-all-caps", "A", true},
+		{"title-case", "Save As", true},
+		{"title-case", "Terms of Use", true},
+		{"title-case", "Terms Of Use", false},
+		{"title-case", "Log In to Lokalise", true},
+		{"title-case", "sign up", false},
+		{"title-case", "What to Look For", true},
+		{"lowercase-unless-proper-noun", "open Lokalise", true},
+		{"lowercase-unless-proper-noun", "Open project", false},
+	}
+	for _, c := range cases {
+		if got := conforms(c.policy, c.term, exc); got != c.want {
+			t.Errorf("conforms(%q, %q) = %v, want %v", c.policy, c.term, got, c.want)
+		}
+	}
+}
+
+func TestRunTermCasing(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{TermCasing: config.TermCasing{Policy: "lowercase"}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description\nlog in;d\nSign Up;d\n"),
+		Path: "g.csv",
+	}
+	out := runTermCasing(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !strings.Contains(out.Result.Message, `"Sign Up" (row 3)`) {
+		t.Fatalf("unexpected message: %q", out.Result.Message)
+	}
+
+	config.Set(nil)
+	out = runTermCasing(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("status without policy = %s, want PASS", out.Result.Status)
+	}
+}
+
+func TestRunTermCasingProperNouns(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{TermCasing: config.TermCasing{Policy: "lowercase-unless-proper-noun"}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;casesensitive;translatable\nAcme Cloud;d;yes;yes\nGitHub;d;no;no\nSign Up;d;no;yes\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runTermCasing(ctx, a, checks.RunOptions{})
+	want := `terms violating lowercase-unless-proper-noun policy: "Sign Up" (row 4) (total 1)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 1 || !strings.Contains(fds[0].Message, `(expected "sign up")`) {
+		t.Fatalf("findings = %+v", fds)
+	}
+}
@@ -0,0 +1,231 @@
+package acronym_consistency
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-inconsistent-acronyms"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runAcronymConsistency,
+		checks.WithPriority(17),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-ACRONYMS", Remediation: checkmeta.Remediation{
+		Hint: "Pick one spelling for each acronym (e.g. \"API\" vs \"Api\") and use it in every row.",
+	}})
+}
+
+func runAcronymConsistency(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateAcronymConsistency,
+		Fix:      nil,
+		PassMsg:  "acronyms are spelled consistently",
+		FailAs:   checks.Warn,
+	})
+}
+
+// variant is one spelling of an acronym and the rows it appears on.
+type variant struct {
+	spelling string
+	rows     []int
+}
+
+func validateAcronymConsistency(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for acronyms"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping acronym consistency)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping acronym consistency)"}
+	}
+
+	cfg := config.Get().Checks.Acronyms
+	ignore := make(map[string]struct{}, len(cfg.Ignore))
+	for _, a := range cfg.Ignore {
+		ignore[acronymKey(strings.TrimSpace(a))] = struct{}{}
+	}
+
+	// key (lowercase letters, no dots) -> spelling -> rows
+	seen := map[string]map[string][]int{}
+	acronymKeys := map[string]struct{}{}
+	for _, row := range tbl.Rows {
+		for _, tok := range tokenize(row.Get(termCol)) {
+			key := acronymKey(tok)
+			n := len([]rune(key))
+			if n < 2 {
+				continue
+			}
+			if _, skip := ignore[key]; skip {
+				continue
+			}
+			// Two-letter words (it, us, on, id) are far more often ordinary
+			// words than acronyms, so only their acronym spellings count.
+			if n == 2 && !isAcronym(tok) {
+				continue
+			}
+			if isAcronym(tok) {
+				acronymKeys[key] = struct{}{}
+			}
+			if seen[key] == nil {
+				seen[key] = map[string][]int{}
+			}
+			rows := seen[key][tok]
+			if len(rows) == 0 || rows[len(rows)-1] != row.Line {
+				seen[key][tok] = append(rows, row.Line)
+			}
+		}
+	}
+
+	offsets := make(map[int]int64, len(tbl.Rows))
+	for _, row := range tbl.Rows {
+		offsets[row.Line] = row.Offset
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+
+	var groups []string
+	var fds []findings.Finding
+	keys := make([]string, 0, len(acronymKeys))
+	for k := range acronymKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		spellings := seen[k]
+		if len(spellings) < 2 {
+			continue
+		}
+		vs := make([]variant, 0, len(spellings))
+		for sp, rows := range spellings {
+			vs = append(vs, variant{spelling: sp, rows: rows})
+		}
+		sort.Slice(vs, func(i, j int) bool { return vs[i].rows[0] < vs[j].rows[0] })
+		parts := make([]string, 0, len(vs))
+		for _, v := range vs {
+			parts = append(parts, strconv.Quote(v.spelling)+" ("+formatRows(v.rows)+")")
+		}
+		for i, v := range vs {
+			others := make([]string, 0, len(vs)-1)
+			for j, o := range vs {
+				if j != i {
+					others = append(others, strconv.Quote(o.spelling))
+				}
+			}
+			msg := strconv.Quote(v.spelling) + " is also spelled " + strings.Join(others, ", ")
+			for _, l := range v.rows {
+				fds = append(fds, findings.At(l, termName, offsets[l], msg))
+			}
+		}
+		groups = append(groups, strings.Join(parts, " vs "))
+	}
+
+	sort.SliceStable(fds, func(i, j int) bool { return fds[i].Row < fds[j].Row })
+	findings.Report(ctx, fds)
+
+	if len(groups) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "acronyms are spelled consistently"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "inconsistent acronym spellings: " + csvutil.JoinLimited(groups, "; ", 10),
+	}
+}
+
+// acronymKey is the spelling-independent form of tok: lowercase, no dots.
+func acronymKey(tok string) string {
+	return strings.ToLower(strings.ReplaceAll(tok, ".", ""))
+}
+
+// tokenize splits a cell into words, keeping inner dots (F.A.Q.) intact.
+func tokenize(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || (unicode.IsPunct(r) && r != '.' && r != '-' && r != '\'')
+	})
+	out := fields[:0]
+	for _, f := range fields {
+		if isDotted(f) {
+			out = append(out, f)
+			continue
+		}
+		f = strings.Trim(f, ".-'")
+		if f != "" {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// isAcronym reports whether tok looks like an acronym: dotted letters (F.A.Q.)
+// or at least two letters that are all uppercase (FAQ).
+func isAcronym(tok string) bool {
+	if isDotted(tok) {
+		return true
+	}
+	letters := 0
+	for _, r := range tok {
+		if !unicode.IsLetter(r) || !unicode.IsUpper(r) {
+			return false
+		}
+		letters++
+	}
+	return letters >= 2
+}
+
+// isDotted matches single letters separated by dots, e.g. "F.A.Q." or "e.g".
+func isDotted(tok string) bool {
+	rs := []rune(tok)
+	if len(rs) < 3 {
+		return false
+	}
+	letters := 0
+	for i, r := range rs {
+		if i%2 == 0 {
+			if !unicode.IsLetter(r) {
+				return false
+			}
+			letters++
+		} else if r != '.' {
+			return false
+		}
+	}
+	return letters >= 2
+}
+
+func formatRows(rows []int) string {
+	parts := make([]string, len(rows))
+	for i, n := range rows {
+		parts[i] = strconv.Itoa(n)
+	}
+	if len(rows) == 1 {
+		return "row " + parts[0]
+	}
+	return "rows " + strings.Join(parts, ", ")
+}
@@ -0,0 +1,81 @@
+package acronym_consistency
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestValidateAcronymConsistency_Inconsistent(t *testing.T) {
+	csv := "term;description\n" +
+		"FAQ;questions\n" +
+		"F.A.Q. page;page\n" +
+		"faq entry;entry\n" +
+		"FAQ section;section\n" +
+		"API key;key\n"
+
+	res := validateAcronymConsistency(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if res.OK {
+		t.Fatalf("expected WARN-worthy result, got OK: %q", res.Msg)
+	}
+	want := `"FAQ" (rows 2, 5) vs "F.A.Q." (row 3) vs "faq" (row 4)`
+	if !strings.Contains(res.Msg, want) {
+		t.Fatalf("message %q does not contain %q", res.Msg, want)
+	}
+	if strings.Contains(res.Msg, "API") {
+		t.Fatalf("consistent acronym reported: %q", res.Msg)
+	}
+}
+
+func TestValidateAcronymConsistency_Consistent(t *testing.T) {
+	csv := "term;description\nAPI;a\nAPI key;b\nfaq;lowercase word only\n"
+	res := validateAcronymConsistency(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if !res.OK {
+		t.Fatalf("expected OK, got %q", res.Msg)
+	}
+}
+
+func TestValidateAcronymConsistency_TwoLetterWords(t *testing.T) {
+	csv := "term;description\n" +
+		"IT department;a\n" +
+		"Save it;b\n" +
+		"US dollar;c\n" +
+		"Contact us;d\n" +
+		"Contact Us page;e\n"
+	res := validateAcronymConsistency(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if !res.OK {
+		t.Fatalf("expected OK, got %q", res.Msg)
+	}
+
+	csv = "term;description\nUS dollar;a\nU.S. customs;b\n"
+	res = validateAcronymConsistency(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if res.OK || !strings.Contains(res.Msg, `"US" (row 2) vs "U.S." (row 3)`) {
+		t.Fatalf("dotted two-letter acronym not reported: %q", res.Msg)
+	}
+}
+
+func TestValidateAcronymConsistency_Ignore(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{Acronyms: config.Acronyms{Ignore: []string{"faq"}}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	csv := "term;description\nFAQ;a\nF.A.Q. page;b\nfaq entry;c\nAPI;d\nApi key;e\n"
+	res := validateAcronymConsistency(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if res.OK {
+		t.Fatalf("expected API to be reported, got OK: %q", res.Msg)
+	}
+	if strings.Contains(res.Msg, "FAQ") {
+		t.Fatalf("ignored acronym reported: %q", res.Msg)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	got := strings.Join(tokenize("Read the F.A.Q. (or FAQ), e.g. now."), "|")
+	want := "Read|the|F.A.Q.|or|FAQ|e.g.|now"
+	if got != want {
+		t.Fatalf("tokenize = %q, want %q", got, want)
+	}
+}
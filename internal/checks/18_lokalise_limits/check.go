@@ -0,0 +1,173 @@
+package lokalise_limits
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "lokalise-limits"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runLokaliseLimits,
+		checks.WithPriority(18),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-LIMITS", Remediation: checkmeta.Remediation{
+		Hint: "Shorten or split the reported values so they fit Lokalise limits; raise limits in lokalise-limits only if your plan allows it.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runLokaliseLimits(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateLokaliseLimits,
+		Fix:      nil,
+		PassMsg:  "glossary is within Lokalise limits (" + lokalise.LimitsVersion + ")",
+	})
+}
+
+// violations collects offending rows per limit, in a stable order, and one
+// finding per offending cell for reports.
+type violations struct {
+	order []string
+	rows  map[string][]string
+	fds   []findings.Finding
+}
+
+func (v *violations) add(kind string, row csvutil.Row, column string) {
+	v.fds = append(v.fds, findings.At(row.Line, column, row.Offset, kind))
+	line := row.Line
+	if v.rows == nil {
+		v.rows = map[string][]string{}
+	}
+	if _, ok := v.rows[kind]; !ok {
+		v.order = append(v.order, kind)
+	}
+	v.rows[kind] = append(v.rows[kind], strconv.Itoa(line))
+}
+
+func validateLokaliseLimits(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate against Lokalise limits"}
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping Lokalise limits)"}
+	}
+
+	lim := config.Get().Limits()
+	var v violations
+
+	termCol := tbl.Col("term")
+	tagsCol := tbl.Col("tags")
+	var descCols, flagCols []int
+	for i, h := range tbl.Header {
+		n := csvutil.NormalizeHeader(h)
+		switch {
+		case n == "description" || strings.HasSuffix(n, "_description"):
+			descCols = append(descCols, i)
+		case n == "casesensitive" || n == "translatable" || n == "forbidden":
+			flagCols = append(flagCols, i)
+		}
+	}
+	langCols := tbl.LangCols()
+	header := func(i int) string { return strings.TrimSpace(tbl.Header[i]) }
+
+	const checkEvery = 1 << 12
+	for i, row := range tbl.Rows {
+		if i%checkEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+			}
+		}
+		if termCol >= 0 && runeLen(row.Get(termCol)) > lim.TermMaxLen {
+			v.add(fmt.Sprintf("term longer than %d chars", lim.TermMaxLen), row, header(termCol))
+		}
+		for _, c := range descCols {
+			if runeLen(row.Get(c)) > lim.DescriptionMaxLen {
+				v.add(fmt.Sprintf("description longer than %d chars", lim.DescriptionMaxLen), row, header(c))
+				break
+			}
+		}
+		for _, c := range langCols {
+			if runeLen(row.Get(c)) > lim.TranslationMaxLen {
+				v.add(fmt.Sprintf("translation longer than %d chars", lim.TranslationMaxLen), row, header(c))
+				break
+			}
+		}
+		if tagsCol >= 0 {
+			tags := splitTags(row.Get(tagsCol))
+			if len(tags) > lim.MaxTagsPerTerm {
+				v.add(fmt.Sprintf("more than %d tags", lim.MaxTagsPerTerm), row, header(tagsCol))
+			}
+			for _, t := range tags {
+				if runeLen(t) > lim.TagMaxLen {
+					v.add(fmt.Sprintf("tag longer than %d chars", lim.TagMaxLen), row, header(tagsCol))
+					break
+				}
+			}
+		}
+		for _, c := range flagCols {
+			if !slices.Contains(lokalise.FlagValues, strings.TrimSpace(row.Get(c))) {
+				v.add("flag outside "+strings.Join(lokalise.FlagValues, "/"), row, header(c))
+				break
+			}
+		}
+	}
+
+	var parts []string
+	if len(tbl.Rows) > lim.MaxTerms {
+		first := tbl.Rows[lim.MaxTerms]
+		v.fds = append(v.fds, findings.At(first.Line, "", first.Offset,
+			fmt.Sprintf("term %d, the first over the maximum of %d", lim.MaxTerms+1, lim.MaxTerms)))
+		parts = append(parts, fmt.Sprintf("%d terms exceed the maximum of %d", len(tbl.Rows), lim.MaxTerms))
+	}
+	findings.Report(ctx, v.fds)
+
+	for _, kind := range v.order {
+		parts = append(parts, kind+": rows "+csvutil.JoinLimited(v.rows[kind], ", ", 10))
+	}
+	if len(parts) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "glossary is within Lokalise limits (" + lokalise.LimitsVersion + ")"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "Lokalise limits (" + lokalise.LimitsVersion + ") exceeded: " + strings.Join(parts, "; "),
+	}
+}
+
+func runeLen(s string) int { return utf8.RuneCountInString(strings.TrimSpace(s)) }
+
+func splitTags(s string) []string {
+	var out []string
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package lokalise_limits
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestValidateLokaliseLimits(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{LokaliseLimits: lokalise.Limits{
+		TermMaxLen:        5,
+		MaxTagsPerTerm:    2,
+		DescriptionMaxLen: 4,
+		MaxTerms:          3,
+	}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	csv := "term;description;forbidden;tags\n" +
+		"short;d;no;a,b\n" +
+		"fine;wordy;no;a\n" +
+		"toolong;d;no;a\n" +
+		"ok;d;maybe;a,b,c\n"
+
+	res := validateLokaliseLimits(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if res.OK {
+		t.Fatal("expected limits to be exceeded")
+	}
+	for _, want := range []string{
+		"4 terms exceed the maximum of 3",
+		"description longer than 4 chars: rows 3 (total 1)",
+		"term longer than 5 chars: rows 4 (total 1)",
+		"flag outside yes/no: rows 5 (total 1)",
+		"more than 2 tags: rows 5 (total 1)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Errorf("message %q lacks %q", res.Msg, want)
+		}
+	}
+}
+
+func TestValidateLokaliseLimits_Defaults(t *testing.T) {
+	config.Set(nil)
+	csv := "term;description;en\nhello;greeting;Hello\n"
+	res := validateLokaliseLimits(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if !res.OK {
+		t.Fatalf("expected OK, got %q", res.Msg)
+	}
+}
+
+func TestValidateLokaliseLimits_MaxTermsFinding(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{LokaliseLimits: lokalise.Limits{MaxTerms: 2}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	ctx, col := findings.WithCollector(context.Background())
+	csv := "term;description\na;d\nb;d\nc;d\n"
+	res := validateLokaliseLimits(ctx, checks.Artifact{Data: []byte(csv)})
+	if res.OK || !strings.Contains(res.Msg, "3 terms exceed the maximum of 2") {
+		t.Fatalf("got %v %q", res.OK, res.Msg)
+	}
+	fds := col.Findings()
+	if len(fds) != 1 || fds[0].Row != 4 || fds[0].Message != "term 3, the first over the maximum of 2" {
+		t.Fatalf("got %+v", fds)
+	}
+}
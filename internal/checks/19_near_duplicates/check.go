@@ -0,0 +1,295 @@
+package near_duplicates
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-near-duplicate-terms"
+
+const (
+	metricLevenshtein = "levenshtein"
+	metricJaroWinkler = "jaro-winkler"
+)
+
+// Default thresholds per metric. Jaro-Winkler rewards shared prefixes, so
+// it needs a higher bar to keep "login" and "logout" apart.
+var defaultThreshold = map[string]float64{
+	metricLevenshtein: 0.85,
+	metricJaroWinkler: 0.92,
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runNearDuplicates,
+		checks.WithPriority(19),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-NEAR-DUPLICATE", Remediation: checkmeta.Remediation{
+		Hint: "Merge terms that mean the same (e.g. \"log in\" vs \"login\"), or list intended ones under ignore.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runNearDuplicates(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateNearDuplicates,
+		Fix:      nil,
+		PassMsg:  "no near-duplicate terms",
+		FailAs:   checks.Warn,
+	})
+}
+
+// entry is a term prepared for comparison.
+type entry struct {
+	term   string
+	norm   []rune
+	line   int
+	offset int64
+}
+
+func validateNearDuplicates(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for near-duplicates"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping near-duplicates)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping near-duplicates)"}
+	}
+
+	cfg := config.Get().Checks.NearDuplicates
+	metric := cfg.Metric
+	if metric == "" {
+		metric = metricLevenshtein
+	}
+	threshold := cfg.Threshold
+	if threshold == 0 {
+		threshold = defaultThreshold[metric]
+	}
+	ignore := make(map[string]struct{}, len(cfg.Ignore))
+	for _, t := range cfg.Ignore {
+		ignore[strings.TrimSpace(t)] = struct{}{}
+	}
+
+	var es []entry
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		if _, skip := ignore[term]; skip {
+			continue
+		}
+		if norm := normalize(term); len(norm) > 0 {
+			es = append(es, entry{term: term, norm: norm, line: row.Line, offset: row.Offset})
+		}
+	}
+	// Sorting by length lets the Levenshtein pass stop once lengths alone
+	// rule a match out.
+	sort.SliceStable(es, func(i, j int) bool { return len(es[i].norm) < len(es[j].norm) })
+
+	type match struct {
+		other int
+		score float64
+	}
+	best := make([]match, len(es))
+	for i := range best {
+		best[i].other = -1
+	}
+	parent := make([]int, len(es))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range es {
+		if i%256 == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+			}
+		}
+		for j := i + 1; j < len(es); j++ {
+			if metric == metricLevenshtein && float64(len(es[i].norm)) < threshold*float64(len(es[j].norm)) {
+				break
+			}
+			// Identical spellings are the duplicate-term check's business.
+			if es[i].term == es[j].term {
+				continue
+			}
+			score := similarity(metric, es[i].norm, es[j].norm, threshold)
+			if score < threshold {
+				continue
+			}
+			parent[find(i)] = find(j)
+			if score > best[i].score {
+				best[i] = match{j, score}
+			}
+			if score > best[j].score {
+				best[j] = match{i, score}
+			}
+		}
+	}
+
+	groups := map[int][]int{}
+	var fds []findings.Finding
+	termName := strings.TrimSpace(tbl.Header[termCol])
+	for i, m := range best {
+		if m.other < 0 {
+			continue
+		}
+		groups[find(i)] = append(groups[find(i)], i)
+		o := es[m.other]
+		msg := fmt.Sprintf("%q is close to %q (row %d, %.0f%% similar)", es[i].term, o.term, o.line, m.score*100)
+		fds = append(fds, findings.At(es[i].line, termName, es[i].offset, msg))
+	}
+	sort.SliceStable(fds, func(i, j int) bool { return fds[i].Row < fds[j].Row })
+	findings.Report(ctx, fds)
+
+	if len(groups) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no near-duplicate terms"}
+	}
+	var parts []string
+	for _, members := range groups {
+		sort.Slice(members, func(x, y int) bool { return es[members[x]].line < es[members[y]].line })
+		ps := make([]string, len(members))
+		for k, idx := range members {
+			ps[k] = strconv.Quote(es[idx].term) + " (row " + strconv.Itoa(es[idx].line) + ")"
+		}
+		parts = append(parts, strings.Join(ps, " ~ "))
+	}
+	sort.Strings(parts)
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "near-duplicate terms: " + csvutil.JoinLimited(parts, "; ", 10),
+	}
+}
+
+// normalize lowercases s and keeps only letters and digits, so spacing,
+// hyphens and case never make terms look different ("Log-in" = "log in").
+func normalize(s string) []rune {
+	var out []rune
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// similarity scores a and b between 0 and 1. For Levenshtein, scores below
+// threshold may be reported as 0 without computing them exactly.
+func similarity(metric string, a, b []rune, threshold float64) float64 {
+	if metric == metricJaroWinkler {
+		return jaroWinkler(a, b)
+	}
+	longest := max(len(a), len(b))
+	limit := int((1-threshold)*float64(longest) + 1e-9)
+	d := levenshtein(a, b, limit)
+	if d > limit {
+		return 0
+	}
+	return 1 - float64(d)/float64(longest)
+}
+
+// levenshtein returns the edit distance of a and b, or limit+1 as soon as
+// it is known to exceed limit.
+func levenshtein(a, b []rune, limit int) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// jaroWinkler is the Jaro similarity boosted by up to four common leading
+// characters (scaling factor 0.1).
+func jaroWinkler(a, b []rune) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	window := max(max(len(a), len(b))/2-1, 0)
+	aMatch := make([]bool, len(a))
+	bMatch := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		lo, hi := max(0, i-window), min(len(b), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !bMatch[j] && a[i] == b[j] {
+				aMatch[i], bMatch[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions, k := 0, 0
+	for i := range a {
+		if !aMatch[i] {
+			continue
+		}
+		for !bMatch[k] {
+			k++
+		}
+		if a[i] != b[k] {
+			transpositions++
+		}
+		k++
+	}
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(a), len(b)) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
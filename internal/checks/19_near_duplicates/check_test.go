@@ -0,0 +1,66 @@
+package near_duplicates
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestSimilarity(t *testing.T) {
+	cases := []struct {
+		metric string
+		a, b   string
+		want   float64
+	}{
+		{metricLevenshtein, "log in", "Log-in", 1},
+		{metricLevenshtein, "color", "colour", 1 - 1.0/6},
+		{metricLevenshtein, "login", "logout", 0}, // below threshold, not computed
+		{metricJaroWinkler, "martha", "marhta", 0.9611},
+		{metricJaroWinkler, "abc", "xyz", 0},
+	}
+	for _, c := range cases {
+		got := similarity(c.metric, normalize(c.a), normalize(c.b), 0.8)
+		if math.Abs(got-c.want) > 0.0001 {
+			t.Errorf("similarity(%s, %q, %q) = %.4f, want %.4f", c.metric, c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestRunNearDuplicates(t *testing.T) {
+	t.Cleanup(func() { config.Set(nil) })
+	a := checks.Artifact{
+		Data: []byte("term;description\nlog in;d\nlogout;d\nLog-in;d\norganisation;d\norganization;d\nlogin;d\n"),
+		Path: "g.csv",
+	}
+
+	ctx, col := findings.WithCollector(context.Background())
+	out := runNearDuplicates(ctx, a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	want := `near-duplicate terms: "log in" (row 2) ~ "Log-in" (row 4) ~ "login" (row 7); "organisation" (row 5) ~ "organization" (row 6) (total 2)`
+	if out.Result.Message != want {
+		t.Fatalf("message = %q\nwant %q", out.Result.Message, want)
+	}
+	if n := len(col.Findings()); n != 5 {
+		t.Fatalf("%d findings, want 5: %v", n, col.Findings())
+	}
+	if got := col.Findings()[0].Message; !strings.Contains(got, `"log in" is close to`) {
+		t.Fatalf("first finding = %q", got)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{NearDuplicates: config.NearDuplicates{
+		Threshold: 0.95,
+		Ignore:    []string{"log in", "Log-in", "login"},
+	}}})
+	out = runNearDuplicates(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("status with ignore and higher threshold = %s, want PASS (%s)", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,98 @@
+package untranslatable_translations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-untranslatable-with-translations"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runUntranslatableTranslations,
+		checks.WithPriority(20),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-TRANSLATABLE", Remediation: checkmeta.Remediation{
+		Hint: "Set translatable to yes for terms that have translations, or clear the translations.",
+	}})
+}
+
+func runUntranslatableTranslations(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateUntranslatableTranslations,
+		Fix:      nil,
+		PassMsg:  "no translations on untranslatable terms",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateUntranslatableTranslations warns about terms marked
+// translatable=no that still carry translations. A translation equal to the
+// term is what "not translatable" means and is not reported. Flag values
+// themselves are validated by no-invalid-flags.
+func validateUntranslatableTranslations(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for translatable terms"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping translatable terms)"}
+	}
+	termCol, flagCol := tbl.Col("term"), tbl.Col("translatable")
+	if termCol < 0 || flagCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' or 'translatable' column found (skipping translatable terms)"}
+	}
+	langCols := tbl.LangCols()
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		if strings.ToLower(strings.TrimSpace(row.Get(flagCol))) != "no" {
+			continue
+		}
+		term := strings.TrimSpace(row.Get(termCol))
+		var langs []string
+		for _, c := range langCols {
+			v := strings.TrimSpace(row.Get(c))
+			if v == "" || v == term {
+				continue
+			}
+			lang := strings.TrimSpace(tbl.Header[c])
+			langs = append(langs, lang)
+			msg := fmt.Sprintf("translation %q on a term marked translatable=no", v)
+			fds = append(fds, findings.At(row.Line, lang, row.Offset, msg))
+		}
+		if len(langs) > 0 {
+			bad = append(bad, fmt.Sprintf("%q (row %d: %s)", term, row.Line, strings.Join(langs, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no translations on untranslatable terms"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms marked translatable=no have translations: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
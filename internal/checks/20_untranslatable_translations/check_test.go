@@ -0,0 +1,40 @@
+package untranslatable_translations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunUntranslatableTranslations(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;translatable;en;de;fr\n" +
+			"Lokalise;brand;no;Lokalise;Lokalise;\n" +
+			"API;d;no;API;Schnittstelle;interface\n" +
+			"key;d;yes;key;Schlüssel;clé\n"),
+		Path: "g.csv",
+	}
+
+	ctx, col := findings.WithCollector(context.Background())
+	out := runUntranslatableTranslations(ctx, a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	want := `terms marked translatable=no have translations: "API" (row 3: de, fr) (total 1)`
+	if out.Result.Message != want {
+		t.Fatalf("message = %q\nwant %q", out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 2 || fds[0].Column != "de" || fds[0].Row != 3 {
+		t.Fatalf("findings = %v", fds)
+	}
+
+	a.Data = []byte("term;description;translatable;en\nLokalise;brand;no;Lokalise\n")
+	out = runUntranslatableTranslations(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("status = %s, want PASS (%s)", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,98 @@
+package forbidden_translations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-forbidden-with-translations"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runForbiddenTranslations,
+		checks.WithPriority(21),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-FORBIDDEN", Remediation: checkmeta.Remediation{
+		Hint: "Clear the translations of forbidden terms, or set forbidden to no if the term may be used.",
+	}})
+}
+
+func runForbiddenTranslations(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateForbiddenTranslations,
+		Fix:      nil,
+		PassMsg:  "no translations on forbidden terms",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateForbiddenTranslations warns about terms marked forbidden=yes that
+// still carry translations: a term that must not be used has nothing to
+// translate to. The term repeated in its own language column is not
+// reported. Flag values themselves are validated by no-invalid-flags.
+func validateForbiddenTranslations(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for forbidden terms"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping forbidden terms)"}
+	}
+	termCol, flagCol := tbl.Col("term"), tbl.Col("forbidden")
+	if termCol < 0 || flagCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' or 'forbidden' column found (skipping forbidden terms)"}
+	}
+	langCols := tbl.LangCols()
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		if strings.ToLower(strings.TrimSpace(row.Get(flagCol))) != "yes" {
+			continue
+		}
+		term := strings.TrimSpace(row.Get(termCol))
+		var langs []string
+		for _, c := range langCols {
+			v := strings.TrimSpace(row.Get(c))
+			if v == "" || v == term {
+				continue
+			}
+			lang := strings.TrimSpace(tbl.Header[c])
+			langs = append(langs, lang)
+			msg := fmt.Sprintf("translation %q on a term marked forbidden=yes", v)
+			fds = append(fds, findings.At(row.Line, lang, row.Offset, msg))
+		}
+		if len(langs) > 0 {
+			bad = append(bad, fmt.Sprintf("%q (row %d: %s)", term, row.Line, strings.Join(langs, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no translations on forbidden terms"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms marked forbidden=yes have translations: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
@@ -0,0 +1,33 @@
+package forbidden_translations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunForbiddenTranslations(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;forbidden;en;de\n" +
+			"whitelist;use allowlist;yes;whitelist;\n" +
+			"blacklist;use denylist;yes;blacklist;Schwarze Liste\n" +
+			"allowlist;d;no;allowlist;Zulassungsliste\n"),
+		Path: "g.csv",
+	}
+
+	ctx, col := findings.WithCollector(context.Background())
+	out := runForbiddenTranslations(ctx, a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	want := `terms marked forbidden=yes have translations: "blacklist" (row 3: de) (total 1)`
+	if out.Result.Message != want {
+		t.Fatalf("message = %q\nwant %q", out.Result.Message, want)
+	}
+	if fds := col.Findings(); len(fds) != 1 || fds[0].Column != "de" || fds[0].Row != 3 {
+		t.Fatalf("findings = %v", fds)
+	}
+}
@@ -0,0 +1,124 @@
+package tag_format
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-tag-format"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runTagFormat,
+		checks.WithPriority(22),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-TAGS", Remediation: checkmeta.Remediation{
+		Hint: "Write tags as a plain comma-separated list (\"ui,billing\") without empty entries, repeats or surrounding spaces.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runTagFormat(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateTagFormat,
+		Fix:      nil,
+		PassMsg:  "tags are well-formed",
+		FailAs:   checks.Warn,
+	})
+}
+
+func validateTagFormat(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for tags"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping tags)"}
+	}
+	tagsCol := tbl.Col("tags")
+	if tagsCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'tags' column found (skipping tags)"}
+	}
+	colName := strings.TrimSpace(tbl.Header[tagsCol])
+
+	var allowed map[string]struct{}
+	if list := config.Get().Checks.Tags.Allowed; len(list) > 0 {
+		allowed = make(map[string]struct{}, len(list))
+		for _, t := range list {
+			allowed[strings.TrimSpace(t)] = struct{}{}
+		}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		problems := tagProblems(row.Get(tagsCol), allowed)
+		for _, p := range problems {
+			fds = append(fds, findings.At(row.Line, colName, row.Offset, p))
+		}
+		if len(problems) > 0 {
+			bad = append(bad, fmt.Sprintf("row %d: %s", row.Line, strings.Join(problems, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "tags are well-formed"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "malformed tags: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// tagProblems lists what is wrong with one tags cell. An empty cell has no
+// tags and is fine.
+func tagProblems(cell string, allowed map[string]struct{}) []string {
+	if strings.TrimSpace(cell) == "" {
+		return nil
+	}
+	var out []string
+	seen := map[string]bool{}
+	for _, raw := range strings.Split(cell, ",") {
+		tag := strings.TrimSpace(raw)
+		switch {
+		case tag == "":
+			out = append(out, "empty tag")
+			continue
+		case tag != raw:
+			out = append(out, fmt.Sprintf("tag %q has surrounding whitespace", raw))
+		}
+		if seen[tag] {
+			out = append(out, fmt.Sprintf("duplicate tag %q", tag))
+			continue
+		}
+		seen[tag] = true
+		if allowed != nil {
+			if _, ok := allowed[tag]; !ok {
+				out = append(out, fmt.Sprintf("tag %q is not in the allowed list", tag))
+			}
+		}
+	}
+	return out
+}
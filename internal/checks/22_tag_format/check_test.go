@@ -0,0 +1,49 @@
+package tag_format
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestTagProblems(t *testing.T) {
+	allowed := map[string]struct{}{"ui": {}, "billing": {}}
+	cases := []struct {
+		cell    string
+		allowed map[string]struct{}
+		want    string
+	}{
+		{"", nil, ""},
+		{"ui,billing", allowed, ""},
+		{"ui,,billing,", nil, "empty tag|empty tag"},
+		{"ui, billing", nil, `tag " billing" has surrounding whitespace`},
+		{"ui,ui", nil, `duplicate tag "ui"`},
+		{"ui,legal", allowed, `tag "legal" is not in the allowed list`},
+	}
+	for _, c := range cases {
+		if got := strings.Join(tagProblems(c.cell, c.allowed), "|"); got != c.want {
+			t.Errorf("tagProblems(%q) = %q, want %q", c.cell, got, c.want)
+		}
+	}
+}
+
+func TestRunTagFormat(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{Tags: config.Tags{Allowed: []string{"ui"}}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;tags\nkey;d;ui\nlog in;d;ui,auth\n"),
+		Path: "g.csv",
+	}
+	out := runTagFormat(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !strings.Contains(out.Result.Message, `row 3: tag "auth" is not in the allowed list`) {
+		t.Fatalf("unexpected message: %q", out.Result.Message)
+	}
+}
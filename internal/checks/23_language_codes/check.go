@@ -0,0 +1,124 @@
+package language_codes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-language-codes"
+
+// supportedCodes loads the Lokalise language list; replaced in tests.
+var supportedCodes = func(ctx context.Context) (map[string]struct{}, error) {
+	token := os.Getenv(lokalise.TokenEnv)
+	if token == "" {
+		return nil, fmt.Errorf("%s is not set", lokalise.TokenEnv)
+	}
+	return lokalise.SupportedCodes(ctx, lokalise.NewClient(token), lokalise.DefaultLanguagesCache())
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runLanguageCodes,
+		checks.WithPriority(23),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-LANG-CODE", Remediation: checkmeta.Remediation{
+		Hint: "Name language columns by language code (en, de_DE, pt-BR), or map them with upload.locale-map.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runLanguageCodes(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateLanguageCodes,
+		Fix:      nil,
+		PassMsg:  "language columns are valid language codes",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateLanguageCodes checks the code each language column is uploaded
+// as (after upload.locale-map): it must be a well-formed BCP 47 tag with
+// known subtags and, when configured, a language Lokalise supports.
+func validateLanguageCodes(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for language codes"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping language codes)"}
+	}
+	cfg := config.Get()
+
+	var supported map[string]struct{}
+	if cfg.Checks.LanguageCodes.Lokalise {
+		supported, err = supportedCodes(ctx)
+		if err != nil {
+			return checks.ValidationResult{OK: false, Msg: "cannot load Lokalise languages", Err: err}
+		}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, c := range tbl.LangCols() {
+		col := strings.TrimSpace(tbl.Header[c])
+		code := lokalise.LokaliseCode(col, cfg.Upload.LocaleMap)
+		problem := codeProblem(code, supported)
+		if problem == "" {
+			continue
+		}
+		if code != col {
+			problem = fmt.Sprintf("%s (mapped to %q)", problem, code)
+		}
+		bad = append(bad, fmt.Sprintf("%q %s", col, problem))
+		fds = append(fds, findings.At(tbl.HeaderLine, col, tbl.HeaderOffset, problem))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "language columns are valid language codes"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "invalid language columns: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// codeProblem describes what is wrong with a Lokalise language code, or
+// returns "". supported, when non-nil, is the set of allowed codes.
+func codeProblem(code string, supported map[string]struct{}) string {
+	tag, err := language.Parse(strings.ReplaceAll(code, "_", "-"))
+	if err != nil || tag == language.Und {
+		return "is not a valid language code"
+	}
+	if supported != nil {
+		if _, ok := supported[lokalise.NormalizeCode(code)]; !ok {
+			return "is not a language Lokalise supports"
+		}
+	}
+	return ""
+}
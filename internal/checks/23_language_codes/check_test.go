@@ -0,0 +1,59 @@
+package language_codes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestCodeProblem(t *testing.T) {
+	supported := map[string]struct{}{"en": {}, "de_de": {}, "pt_br": {}}
+	cases := []struct {
+		code      string
+		supported map[string]struct{}
+		want      string
+	}{
+		{"en", nil, ""},
+		{"de_DE", nil, ""},
+		{"pt-BR", nil, ""},
+		{"zh_Hans_CN", nil, ""},
+		{"english", nil, "is not a valid language code"},
+		{"xx", nil, "is not a valid language code"},
+		{"e", nil, "is not a valid language code"},
+		{"pt-BR", supported, ""},
+		{"enn", nil, ""}, // Engenni: well-formed and known
+		{"enn", supported, "is not a language Lokalise supports"},
+	}
+	for _, c := range cases {
+		if got := codeProblem(c.code, c.supported); got != c.want {
+			t.Errorf("codeProblem(%q) = %q, want %q", c.code, got, c.want)
+		}
+	}
+}
+
+func TestRunLanguageCodes(t *testing.T) {
+	config.Set(&config.Config{
+		Checks: config.Checks{LanguageCodes: config.LanguageCodes{Lokalise: true}},
+		Upload: config.Upload{LocaleMap: map[string]string{"german": "de_DE"}},
+	})
+	supportedCodes = func(context.Context) (map[string]struct{}, error) {
+		return map[string]struct{}{"en": {}, "de_de": {}}, nil
+	}
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;en;german;enn;fr_description\nkey;d;key;Schlüssel;x;\n"),
+		Path: "g.csv",
+	}
+	out := runLanguageCodes(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	want := `invalid language columns: "enn" is not a language Lokalise supports (total 1)`
+	if out.Result.Message != want {
+		t.Fatalf("message = %q\nwant %q", out.Result.Message, want)
+	}
+}
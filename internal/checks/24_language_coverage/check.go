@@ -0,0 +1,168 @@
+package language_coverage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "expected-languages"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runExpectedLanguages,
+		// Right after ensure-term-description-header (9, which sorts first)
+		// and before ensure-allowed-columns-header (10), whose fix would
+		// append the missing languages after the flag columns.
+		checks.WithPriority(9),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-LANG-COVERAGE", Remediation: checkmeta.Remediation{
+		Hint: "Add a column for every language passed with --langs (--fix inserts them empty), and drop or declare the extra ones.",
+	}, After: []string{"ensure-semicolon-separators", "ensure-term-description-header"}})
+}
+
+// runExpectedLanguages fails when a language from --langs has no column and
+// only warns when the file has columns for languages not in --langs. With
+// fixing enabled, missing languages get empty columns instead. It builds
+// the outcome itself because the status depends on which side differs.
+func runExpectedLanguages(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	if err := ctx.Err(); err != nil {
+		return checks.OutcomeKeep(checks.Error, checkName, "validation cancelled", a, "")
+	}
+	if len(a.Langs) == 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no expected languages given (--langs); skipping", a, "")
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no content to validate for expected languages", a, "")
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no parsable header (skipping expected languages)", a, "")
+	}
+
+	missing, extra := compare(a.Langs, tbl)
+	var fixed *checks.FixResult
+	if len(missing) > 0 && opts.FixMode != checks.FixNone {
+		data, err := insertLanguages(a.Data, tbl, missing)
+		if err != nil {
+			return checks.OutcomeKeep(checks.Error, checkName, "failed to auto-fix: "+err.Error(), a, "")
+		}
+		fixed = &checks.FixResult{Data: data, Path: a.Path, DidChange: true, Note: "added columns for " + quoteAll(missing)}
+		missing = nil
+	}
+
+	var fds []findings.Finding
+	for _, l := range missing {
+		f := findings.At(tbl.HeaderLine, "", tbl.HeaderOffset, fmt.Sprintf("no column for expected language %q", l))
+		f.Severity = checks.Fail
+		fds = append(fds, f)
+	}
+	for _, col := range extra {
+		f := findings.At(tbl.HeaderLine, col, tbl.HeaderOffset, fmt.Sprintf("language %q is not in --langs", col))
+		f.Severity = checks.Warn
+		fds = append(fds, f)
+	}
+	findings.Report(ctx, fds)
+
+	var parts []string
+	if len(missing) > 0 {
+		parts = append(parts, "missing expected languages: "+quoteAll(missing))
+	}
+	if len(extra) > 0 {
+		parts = append(parts, "unexpected languages: "+quoteAll(extra))
+	}
+	switch {
+	case len(missing) > 0:
+		return checks.OutcomeKeep(checks.Fail, checkName, strings.Join(parts, "; "), a, "")
+	case fixed != nil && len(extra) > 0:
+		return checks.OutcomeWithFinal(checks.Warn, checkName, strings.Join(parts, "; "), *fixed)
+	case fixed != nil:
+		return checks.OutcomeWithFinal(checks.Pass, checkName, "auto-fix applied: added missing language columns", *fixed)
+	case len(extra) > 0:
+		return checks.OutcomeKeep(checks.Warn, checkName, strings.Join(parts, "; "), a, "")
+	}
+	return checks.OutcomeKeep(checks.Pass, checkName, "language columns match --langs", a, "")
+}
+
+// flagCols are the service columns Lokalise templates put after the
+// languages.
+var flagCols = []string{"casesensitive", "translatable", "forbidden", "tags"}
+
+// insertLanguages adds an empty column for each of langs, named as given,
+// before the first flag column or at the end of the header. Rows too short
+// to reach that position are left alone.
+func insertLanguages(data []byte, tbl *csvutil.Table, langs []string) ([]byte, error) {
+	at := len(tbl.Header)
+	for _, name := range flagCols {
+		if c := tbl.Col(name); c >= 0 && c < at {
+			at = c
+		}
+	}
+	out, _, err := csvutil.Replace(data, func(line int, rec []string) ([]string, bool) {
+		if line < tbl.HeaderLine || len(rec) < at {
+			return nil, false
+		}
+		cells := make([]string, len(langs))
+		if line == tbl.HeaderLine {
+			copy(cells, langs)
+		}
+		return slices.Concat(rec[:at], cells, rec[at:]), true
+	})
+	return out, err
+}
+
+// compare returns the expected languages without a column and the
+// language columns that were not expected, in input order. Codes match
+// case-insensitively and regardless of "-" vs "_".
+func compare(expected []string, tbl *csvutil.Table) (missing, extra []string) {
+	want := map[string]bool{}
+	for _, l := range expected {
+		if l = strings.TrimSpace(l); l != "" {
+			want[lokalise.NormalizeCode(l)] = false
+		}
+	}
+	for _, c := range tbl.LangCols() {
+		col := strings.TrimSpace(tbl.Header[c])
+		code := lokalise.NormalizeCode(col)
+		if _, ok := want[code]; ok {
+			want[code] = true
+			continue
+		}
+		extra = append(extra, col)
+	}
+	seen := map[string]bool{}
+	for _, l := range expected {
+		l = strings.TrimSpace(l)
+		code := lokalise.NormalizeCode(l)
+		if l != "" && !want[code] && !seen[code] {
+			seen[code] = true
+			missing = append(missing, l)
+		}
+	}
+	return missing, extra
+}
+
+func quoteAll(xs []string) string {
+	q := make([]string, len(xs))
+	for i, x := range xs {
+		q[i] = strconv.Quote(x)
+	}
+	return strings.Join(q, ", ")
+}
@@ -0,0 +1,54 @@
+package language_coverage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunExpectedLanguages(t *testing.T) {
+	data := []byte("term;description;en;pt_BR;es;es_description\nkey;d;key;chave;clave;\n")
+	cases := []struct {
+		langs    []string
+		status   checks.Status
+		msg      string
+		findings int
+	}{
+		{nil, checks.Pass, "no expected languages given (--langs); skipping", 0},
+		{[]string{"en", "pt-BR", "ES"}, checks.Pass, "language columns match --langs", 0},
+		{[]string{"en", "pt-BR"}, checks.Warn, `unexpected languages: "es"`, 1},
+		{[]string{"en", "pt_BR", "de", "fr"}, checks.Fail, `missing expected languages: "de", "fr"; unexpected languages: "es"`, 3},
+	}
+	for _, c := range cases {
+		ctx, col := findings.WithCollector(context.Background())
+		out := runExpectedLanguages(ctx, checks.Artifact{Data: data, Path: "g.csv", Langs: c.langs}, checks.RunOptions{})
+		if out.Result.Status != c.status || out.Result.Message != c.msg {
+			t.Errorf("langs %v: %s %q, want %s %q", c.langs, out.Result.Status, out.Result.Message, c.status, c.msg)
+		}
+		if n := len(col.Findings()); n != c.findings {
+			t.Errorf("langs %v: %d findings, want %d", c.langs, n, c.findings)
+		}
+	}
+}
+
+func TestRunExpectedLanguagesFix(t *testing.T) {
+	data := []byte("term;description;en;es;casesensitive;tags\r\nkey;d;key;clave;no;ui\r\nshort;d\r\n")
+	opts := checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true}
+
+	out := runExpectedLanguages(context.Background(), checks.Artifact{Data: data, Path: "g.csv", Langs: []string{"en", "es", "de", "fr"}}, opts)
+	want := "term;description;en;es;de;fr;casesensitive;tags\r\nkey;d;key;clave;;;no;ui\r\nshort;d\r\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want {
+		t.Fatalf("%s %q\n%q", out.Result.Status, out.Result.Message, out.Final.Data)
+	}
+	if out.Final.Note != `added columns for "de", "fr"` {
+		t.Errorf("note %q", out.Final.Note)
+	}
+
+	out = runExpectedLanguages(context.Background(), checks.Artifact{Data: []byte("term;description;es\nkey;d;clave\n"), Path: "g.csv", Langs: []string{"de"}}, opts)
+	if out.Result.Status != checks.Warn || string(out.Final.Data) != "term;description;es;de\nkey;d;clave;\n" {
+		t.Fatalf("%s %q\n%q", out.Result.Status, out.Result.Message, out.Final.Data)
+	}
+}
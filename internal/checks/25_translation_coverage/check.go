@@ -0,0 +1,137 @@
+package translation_coverage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-translation-coverage"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runTranslationCoverage,
+		checks.WithPriority(25),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-COVERAGE", Remediation: checkmeta.Remediation{
+		Hint: "Fill in the missing translations, or lower min-percent for languages that are still in progress.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runTranslationCoverage(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateTranslationCoverage,
+		Fix:      nil,
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateTranslationCoverage counts, per language column, the rows with a
+// translation. Rows that need none (translatable=no or forbidden=yes) are
+// left out of the count. Without a configured percentage there is nothing
+// to measure against; empty cells as such are warn-missing-translations.
+func validateTranslationCoverage(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	cfg := config.Get().Checks.Coverage
+	if cfg.MinPercent == nil && len(cfg.Languages) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no coverage percentage configured (skipping)"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for translation coverage"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping translation coverage)"}
+	}
+	langCols := tbl.LangCols()
+	if len(langCols) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no language columns (skipping translation coverage)"}
+	}
+	translatableCol, forbiddenCol := tbl.Col("translatable"), tbl.Col("forbidden")
+
+	needed := 0
+	filled := make([]int, len(langCols))
+	for _, row := range tbl.Rows {
+		if flag(row.Get(translatableCol)) == "no" || flag(row.Get(forbiddenCol)) == "yes" {
+			continue
+		}
+		needed++
+		for i, c := range langCols {
+			if strings.TrimSpace(row.Get(c)) != "" {
+				filled[i]++
+			}
+		}
+	}
+	if needed == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no rows need translations"}
+	}
+
+	var low, all []string
+	var fds []findings.Finding
+	for i, c := range langCols {
+		lang := strings.TrimSpace(tbl.Header[c])
+		pct := 100 * float64(filled[i]) / float64(needed)
+		all = append(all, fmt.Sprintf("%s %s%%", lang, formatPct(pct)))
+		minPct, ok := minFor(cfg, lang)
+		if !ok || pct >= minPct {
+			continue
+		}
+		msg := fmt.Sprintf("%d of %d rows translated (%s%%), below %s%%", filled[i], needed, formatPct(pct), formatPct(minPct))
+		low = append(low, lang+": "+msg)
+		fds = append(fds, findings.At(tbl.HeaderLine, lang, tbl.HeaderOffset, msg))
+	}
+	findings.Report(ctx, fds)
+
+	if len(low) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "translation coverage: " + strings.Join(all, ", ")}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "low translation coverage: " + csvutil.JoinLimited(low, "; ", 10),
+	}
+}
+
+// minFor returns the required coverage for a language column: a
+// per-language override (matched case-insensitively), else MinPercent. A
+// language with neither is not held to any.
+func minFor(cfg config.Coverage, lang string) (float64, bool) {
+	for l, p := range cfg.Languages {
+		if strings.EqualFold(strings.TrimSpace(l), lang) {
+			return p, true
+		}
+	}
+	if cfg.MinPercent != nil {
+		return *cfg.MinPercent, true
+	}
+	return 0, false
+}
+
+func flag(v string) string {
+	return strings.ToLower(strings.TrimSpace(v))
+}
+
+// formatPct renders a percentage with at most one decimal ("87.5", "100").
+func formatPct(p float64) string {
+	return strconv.FormatFloat(float64(int(p*10))/10, 'f', -1, 64)
+}
@@ -0,0 +1,61 @@
+package translation_coverage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunTranslationCoverage(t *testing.T) {
+	t.Cleanup(func() { config.Set(nil) })
+	a := checks.Artifact{
+		Data: []byte("term;description;translatable;en;de;fr\n" +
+			"key;d;yes;key;Schlüssel;\n" +
+			"cart;d;yes;cart;;panier\n" +
+			"Lokalise;brand;no;Lokalise;;\n" +
+			"save;d;yes;save;speichern;\n"),
+		Path: "g.csv",
+	}
+
+	// Not configured: empty cells are left to warn-missing-translations.
+	out := runTranslationCoverage(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass || out.Result.Message != "no coverage percentage configured (skipping)" {
+		t.Fatalf("unconfigured: got %s %q", out.Result.Status, out.Result.Message)
+	}
+
+	full := 100.0
+	config.Set(&config.Config{Checks: config.Checks{Coverage: config.Coverage{MinPercent: &full}}})
+	ctx, col := findings.WithCollector(context.Background())
+	out = runTranslationCoverage(ctx, a, checks.RunOptions{})
+	want := "low translation coverage: de: 2 of 3 rows translated (66.6%), below 100%; fr: 1 of 3 rows translated (33.3%), below 100% (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	if fds := col.Findings(); len(fds) != 2 || fds[0].Column != "de" {
+		t.Fatalf("findings = %v", fds)
+	}
+
+	minPct := 60.0
+	config.Set(&config.Config{Checks: config.Checks{Coverage: config.Coverage{
+		MinPercent: &minPct,
+		Languages:  map[string]float64{"FR": 30},
+	}}})
+	out = runTranslationCoverage(context.Background(), a, checks.RunOptions{})
+	want = "translation coverage: en 100%, de 66.6%, fr 33.3%"
+	if out.Result.Status != checks.Pass || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant PASS %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	// Only the listed languages are held to a percentage.
+	config.Set(&config.Config{Checks: config.Checks{Coverage: config.Coverage{
+		Languages: map[string]float64{"de": 50},
+	}}})
+	out = runTranslationCoverage(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("languages only: got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
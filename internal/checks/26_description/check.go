@@ -0,0 +1,92 @@
+package description
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "description-content"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runDescription,
+		checks.WithPriority(26),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-DESCRIPTION", Remediation: checkmeta.Remediation{
+		Hint: "Give every term a short description, and shorten descriptions over the Lokalise limit.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+// runDescription fails on descriptions longer than the Lokalise limit and
+// only warns about empty ones. It builds the outcome itself because the
+// status depends on which problem was found.
+func runDescription(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	if err := ctx.Err(); err != nil {
+		return checks.OutcomeKeep(checks.Error, checkName, "validation cancelled", a, "")
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no content to validate for descriptions", a, "")
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no parsable header (skipping descriptions)", a, "")
+	}
+	descCol := tbl.Col("description")
+	if descCol < 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no 'description' column found (skipping descriptions)", a, "")
+	}
+	colName := strings.TrimSpace(tbl.Header[descCol])
+	maxLen := config.Get().Limits().DescriptionMaxLen
+
+	var empty, long []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		desc := strings.TrimSpace(row.Get(descCol))
+		switch n := utf8.RuneCountInString(desc); {
+		case n == 0:
+			empty = append(empty, fmt.Sprint(row.Line))
+			f := findings.At(row.Line, colName, row.Offset, "empty description")
+			f.Severity = checks.Warn
+			fds = append(fds, f)
+		case n > maxLen:
+			long = append(long, fmt.Sprintf("%d (%d chars)", row.Line, n))
+			f := findings.At(row.Line, colName, row.Offset, fmt.Sprintf("description is %d chars, over the limit of %d", n, maxLen))
+			f.Severity = checks.Fail
+			fds = append(fds, f)
+		}
+	}
+	findings.Report(ctx, fds)
+
+	var parts []string
+	if len(long) > 0 {
+		parts = append(parts, fmt.Sprintf("descriptions longer than %d chars: rows %s", maxLen, csvutil.JoinLimited(long, ", ", 10)))
+	}
+	if len(empty) > 0 {
+		parts = append(parts, "empty descriptions: rows "+csvutil.JoinLimited(empty, ", ", 10))
+	}
+	switch {
+	case len(long) > 0:
+		return checks.OutcomeKeep(checks.Fail, checkName, strings.Join(parts, "; "), a, "")
+	case len(empty) > 0:
+		return checks.OutcomeKeep(checks.Warn, checkName, strings.Join(parts, "; "), a, "")
+	}
+	return checks.OutcomeKeep(checks.Pass, checkName, "every term has a description within the limit", a, "")
+}
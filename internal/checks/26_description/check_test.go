@@ -0,0 +1,36 @@
+package description
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+)
+
+func TestRunDescription(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{LokaliseLimits: lokalise.Limits{DescriptionMaxLen: 10}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	run := func(csv string) checks.CheckOutcome {
+		return runDescription(context.Background(), checks.Artifact{Data: []byte(csv), Path: "g.csv"}, checks.RunOptions{})
+	}
+
+	out := run("term;description\nkey;a key\ncart;\n")
+	if out.Result.Status != checks.Warn || out.Result.Message != "empty descriptions: rows 3 (total 1)" {
+		t.Fatalf("empty: %s %q", out.Result.Status, out.Result.Message)
+	}
+
+	out = run("term;description\nkey;" + strings.Repeat("ä", 12) + "\ncart;\n")
+	want := "descriptions longer than 10 chars: rows 2 (12 chars) (total 1); empty descriptions: rows 3 (total 1)"
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("long: %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	if out = run("term;description\nkey;a key\n"); out.Result.Status != checks.Pass {
+		t.Fatalf("ok: %s %q", out.Result.Status, out.Result.Message)
+	}
+}
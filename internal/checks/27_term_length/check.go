@@ -0,0 +1,126 @@
+package term_length
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-long-terms"
+
+// defaultMaxLength is far below the Lokalise limit: glossary terms are words
+// and short phrases, not UI strings.
+const defaultMaxLength = 50
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runTermLength,
+		checks.WithPriority(27),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-TERM-LENGTH", Remediation: checkmeta.Remediation{
+		Hint: "Reduce the reported rows to the term itself and move the rest into the description.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runTermLength(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateTermLength,
+		Fix:      nil,
+		PassMsg:  "terms are of reasonable length",
+		FailAs:   checks.Warn,
+	})
+}
+
+func validateTermLength(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for term length"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping term length)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping term length)"}
+	}
+	maxLen := config.Get().Checks.TermLength.MaxLength
+	if maxLen == 0 {
+		maxLen = defaultMaxLength
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		var why string
+		switch n := utf8.RuneCountInString(term); {
+		case multiSentence(term):
+			why = "looks like several sentences"
+		case n > maxLen:
+			why = fmt.Sprintf("is %d chars, over %d", n, maxLen)
+		default:
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("row %d %s", row.Line, why))
+		fds = append(fds, findings.At(row.Line, termName, row.Offset, "term "+why))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "terms are of reasonable length"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "suspiciously long terms: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// multiSentence reports whether s has a sentence end followed by another
+// sentence: a word of two or more letters, then ".", "!" or "?", whitespace
+// and an uppercase letter. Abbreviations like "e.g. Foo" do not count.
+func multiSentence(s string) bool {
+	rs := []rune(s)
+	for i := 2; i+2 < len(rs); i++ {
+		if !strings.ContainsRune(".!?。！？", rs[i]) {
+			continue
+		}
+		if !unicode.IsLetter(rs[i-1]) || !unicode.IsLetter(rs[i-2]) {
+			continue
+		}
+		j := i + 1
+		if !unicode.IsSpace(rs[j]) {
+			continue
+		}
+		for j < len(rs) && unicode.IsSpace(rs[j]) {
+			j++
+		}
+		if j < len(rs) && unicode.IsUpper(rs[j]) {
+			return true
+		}
+	}
+	return false
+}
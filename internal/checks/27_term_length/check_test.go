@@ -0,0 +1,45 @@
+package term_length
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestMultiSentence(t *testing.T) {
+	cases := map[string]bool{
+		"log in":                                 false,
+		"Save your changes. Then close the app.": true,
+		"Are you sure? Click OK":                 true,
+		"e.g. Settings":                          false,
+		"Node.js":                                false,
+		"end.":                                   false,
+	}
+	for s, want := range cases {
+		if got := multiSentence(s); got != want {
+			t.Errorf("multiSentence(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestRunTermLength(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{TermLength: config.TermLength{MaxLength: 20}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description\n" +
+			"log in;d\n" +
+			strings.Repeat("x", 21) + ";d\n" +
+			"Save changes. Close it;d\n"),
+		Path: "g.csv",
+	}
+	out := runTermLength(context.Background(), a, checks.RunOptions{})
+	want := "suspiciously long terms: row 3 is 21 chars, over 20; row 4 looks like several sentences (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
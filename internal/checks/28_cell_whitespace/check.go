@@ -0,0 +1,162 @@
+package cell_whitespace
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-cell-whitespace"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runCellWhitespace,
+		checks.WithPriority(28),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-WHITESPACE", Remediation: checkmeta.Remediation{
+		Hint: "Remove spaces, tabs and non-breaking spaces at the start and end of the reported cells, or run with --fix.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}, After: []string{"ensure-semicolon-separators"}})
+}
+
+func runCellWhitespace(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateCellWhitespace,
+		Fix:              fixCellWhitespace,
+		PassMsg:          "no leading or trailing whitespace in cells",
+		FixedMsg:         "trimmed whitespace around cells",
+		AppliedMsg:       "auto-fix applied: trimmed whitespace around cells",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+	})
+}
+
+// validateCellWhitespace reports term, description and translation cells
+// that start or end with whitespace (including non-breaking spaces), except
+// in the configured skip-columns.
+func validateCellWhitespace(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for whitespace"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping whitespace)"}
+	}
+	cols := columns(tbl)
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			where := surrounding(row.Get(c))
+			if where == "" {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, where+" whitespace"))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no leading or trailing whitespace in cells"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells with leading or trailing whitespace: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// fixCellWhitespace trims the cells validateCellWhitespace reports.
+func fixCellWhitespace(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.NoFix(a, "no parsable header")
+	}
+	cols := columns(tbl)
+	cells := 0
+	out, changed, err := csvutil.Rewrite(a.Data, func(line int, rec []string) bool {
+		if line == tbl.HeaderLine {
+			return false
+		}
+		edited := false
+		for _, c := range cols {
+			if c < len(rec) && surrounding(rec[c]) != "" {
+				rec[c] = strings.TrimSpace(rec[c])
+				cells++
+				edited = true
+			}
+		}
+		return edited
+	})
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	return checks.FixResult{Data: out, DidChange: changed, Note: fmt.Sprintf("trimmed %d cell(s)", cells)}, nil
+}
+
+// columns returns the term, description and language columns that are not
+// skipped by configuration.
+func columns(tbl *csvutil.Table) []int {
+	var cols []int
+	for _, name := range []string{"term", "description"} {
+		if c := tbl.Col(name); c >= 0 {
+			cols = append(cols, c)
+		}
+	}
+	cols = append(cols, tbl.LangCols()...)
+	skip := config.Get().Checks.CellWhitespace
+	kept := cols[:0]
+	for _, c := range cols {
+		if !skip.Skips(tbl.Header[c]) {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// surrounding says where s has whitespace: "leading", "trailing",
+// "leading and trailing" or "" for none. Blank cells are not reported.
+func surrounding(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return ""
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	last, _ := utf8.DecodeLastRuneInString(s)
+	lead, trail := unicode.IsSpace(first), unicode.IsSpace(last)
+	switch {
+	case lead && trail:
+		return "leading and trailing"
+	case lead:
+		return "leading"
+	case trail:
+		return "trailing"
+	}
+	return ""
+}
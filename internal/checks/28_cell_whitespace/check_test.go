@@ -0,0 +1,63 @@
+package cell_whitespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestSurrounding(t *testing.T) {
+	cases := map[string]string{
+		"key":         "",
+		"":            "",
+		"   ":         "",
+		" key":        "leading",
+		"key\t":       "trailing",
+		"\u00a0key ":  "leading and trailing",
+		"two  spaces": "",
+	}
+	for s, want := range cases {
+		if got := surrounding(s); got != want {
+			t.Errorf("surrounding(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestRunCellWhitespace(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;tags;de\nkey ;d;ui ;Schlüssel\ncart;d;; Warenkorb\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runCellWhitespace(ctx, a, checks.RunOptions{})
+	want := "cells with leading or trailing whitespace: row 2 term, row 3 de (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 2 || fds[1].Column != "de" || fds[1].Message != "leading whitespace" {
+		t.Fatalf("findings = %v", fds)
+	}
+}
+
+func TestFixCellWhitespace(t *testing.T) {
+	data := "\ufeffterm;description;tags;de\nkey ;d;ui ;Schlüssel\n\"cart\u00a0\";\" a; b \";; Warenkorb\n"
+	a := checks.Artifact{Data: []byte(data), Path: "g.csv"}
+	out := runCellWhitespace(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	want := "\ufeffterm;description;tags;de\nkey;d;ui ;Schlüssel\ncart;\"a; b\";;Warenkorb\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want || out.Final.Note != "trimmed 4 cell(s)" {
+		t.Fatalf("got %s %q, note %q\n%q", out.Result.Status, out.Result.Message, out.Final.Note, out.Final.Data)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{CellWhitespace: config.Whitespace{SkipColumns: []string{"de", "Description"}}}})
+	t.Cleanup(func() { config.Set(nil) })
+	out = runCellWhitespace(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	want = "\ufeffterm;description;tags;de\nkey;d;ui ;Schlüssel\ncart;\" a; b \";; Warenkorb\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want {
+		t.Fatalf("skip-columns: got %s %q\n%q", out.Result.Status, out.Result.Message, out.Final.Data)
+	}
+}
@@ -0,0 +1,202 @@
+package inner_whitespace
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-inner-whitespace"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runInnerWhitespace,
+		checks.WithPriority(29),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-INNER-WHITESPACE", Remediation: checkmeta.Remediation{
+		Hint: "Replace tabs and runs of spaces inside the reported cells with a single space, or run with --fix.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}, After: []string{"ensure-semicolon-separators"}})
+}
+
+func runInnerWhitespace(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateInnerWhitespace,
+		Fix:              fixInnerWhitespace,
+		PassMsg:          "no double spaces or tabs inside cells",
+		FixedMsg:         "collapsed double spaces and tabs inside cells",
+		AppliedMsg:       "auto-fix applied: collapsed double spaces and tabs inside cells",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+	})
+}
+
+// validateInnerWhitespace reports text cells (term, descriptions and
+// translations) containing a tab or two or more spaces in a row, except in
+// the configured skip-columns. Whitespace at the start or end of a cell is
+// left to warn-cell-whitespace.
+func validateInnerWhitespace(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for inner whitespace"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping inner whitespace)"}
+	}
+	cols := columns(tbl)
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			what := innerProblem(row.Get(c))
+			if what == "" {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, what))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no double spaces or tabs inside cells"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells with double spaces or tabs: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// fixInnerWhitespace collapses each run of spaces and tabs inside the cells
+// validateInnerWhitespace reports into one space. Line breaks and the
+// whitespace around the value are kept.
+func fixInnerWhitespace(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.NoFix(a, "no parsable header")
+	}
+	cols := columns(tbl)
+	cells := 0
+	out, changed, err := csvutil.Rewrite(a.Data, func(line int, rec []string) bool {
+		if line == tbl.HeaderLine {
+			return false
+		}
+		edited := false
+		for _, c := range cols {
+			if c < len(rec) && innerProblem(rec[c]) != "" {
+				rec[c] = collapse(rec[c])
+				cells++
+				edited = true
+			}
+		}
+		return edited
+	})
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	return checks.FixResult{Data: out, DidChange: changed, Note: fmt.Sprintf("collapsed whitespace in %d cell(s)", cells)}, nil
+}
+
+// columns returns the term, description and language columns that are not
+// skipped by configuration.
+func columns(tbl *csvutil.Table) []int {
+	var cols []int
+	for i, h := range tbl.Header {
+		n := csvutil.NormalizeHeader(h)
+		if n == "term" || n == "description" || strings.HasSuffix(n, "_description") {
+			cols = append(cols, i)
+		}
+	}
+	cols = append(cols, tbl.LangCols()...)
+	skip := config.Get().Checks.InnerWhitespace
+	kept := cols[:0]
+	for _, c := range cols {
+		if !skip.Skips(tbl.Header[c]) {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// collapse replaces each run of spaces, tabs and non-breaking spaces inside
+// s with one space, or one non-breaking space when the run holds nothing
+// else. Leading and trailing whitespace is kept.
+func collapse(s string) string {
+	inner := strings.TrimSpace(s)
+	if inner == "" {
+		return s
+	}
+	start := strings.Index(s, inner)
+	var b strings.Builder
+	b.WriteString(s[:start])
+	run, nbspOnly := 0, true
+	flush := func() {
+		switch {
+		case run == 0:
+		case nbspOnly:
+			b.WriteRune('\u00a0')
+		default:
+			b.WriteByte(' ')
+		}
+		run, nbspOnly = 0, true
+	}
+	for _, r := range inner {
+		if r == ' ' || r == '\t' || r == '\u00a0' {
+			run++
+			nbspOnly = nbspOnly && r == '\u00a0'
+			continue
+		}
+		flush()
+		b.WriteRune(r)
+	}
+	b.WriteString(s[start+len(inner):])
+	return b.String()
+}
+
+// innerProblem describes the whitespace artifacts inside s, or returns "".
+// Non-breaking spaces count as spaces.
+func innerProblem(s string) string {
+	s = strings.TrimSpace(s)
+	var problems []string
+	if strings.ContainsRune(s, '\t') {
+		problems = append(problems, "tab")
+	}
+	prevSpace := false
+	for _, r := range s {
+		isSpace := r == ' ' || r == '\u00a0'
+		if isSpace && prevSpace {
+			problems = append(problems, "double space")
+			break
+		}
+		prevSpace = isSpace
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return strings.Join(problems, " and ") + " inside the value"
+}
@@ -0,0 +1,73 @@
+package inner_whitespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestInnerProblem(t *testing.T) {
+	cases := map[string]string{
+		"log in":           "",
+		" log in ":         "",
+		"log  in":          "double space inside the value",
+		"log\u00a0 in":     "double space inside the value",
+		"log\tin":          "tab inside the value",
+		"a\tb  c":          "tab and double space inside the value",
+		"\tleading tab ok": "",
+	}
+	for s, want := range cases {
+		if got := innerProblem(s); got != want {
+			t.Errorf("innerProblem(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestRunInnerWhitespace(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;de;de_description\nlog  in;d;anmelden;x\ncart;d;Waren\tkorb;a  b\n"),
+		Path: "g.csv",
+	}
+	out := runInnerWhitespace(context.Background(), a, checks.RunOptions{})
+	want := "cells with double spaces or tabs: row 2 term, row 3 de_description, row 3 de (total 3)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestCollapse(t *testing.T) {
+	cases := map[string]string{
+		"log  in":             "log in",
+		"Waren\tkorb":         "Waren korb",
+		" a \t b ":            " a b ",
+		"100\u00a0€":          "100\u00a0€",
+		"100\u00a0\u00a0€":    "100\u00a0€",
+		"a\u00a0 b":           "a b",
+		"line one\nline  two": "line one\nline two",
+	}
+	for s, want := range cases {
+		if got := collapse(s); got != want {
+			t.Errorf("collapse(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestFixInnerWhitespace(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Checks.InnerWhitespace.SkipColumns = []string{"*_DESCRIPTION"}
+	config.Set(cfg)
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;de;de_description\r\nlog  in;d;anmelden;x\r\ncart;d;Waren\tkorb;a  b\r\n"),
+		Path: "g.csv",
+	}
+	out := runInnerWhitespace(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	want := "term;description;de;de_description\r\nlog in;d;anmelden;x\r\ncart;d;Waren korb;a  b\r\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want || out.Final.Note != "collapsed whitespace in 2 cell(s)" {
+		t.Fatalf("got %s %q, note %q\n%q", out.Result.Status, out.Result.Message, out.Final.Note, out.Final.Data)
+	}
+}
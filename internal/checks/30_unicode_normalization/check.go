@@ -0,0 +1,146 @@
+package unicode_normalization
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-unicode-normalization"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runUnicodeNormalization,
+		checks.WithPriority(30),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-NFC", Remediation: checkmeta.Remediation{
+		Hint: "Run with --fix or re-save the file with Unicode NFC normalization (composed accents), e.g. `uconv -x any-nfc`.",
+	}, After: []string{"ensure-semicolon-separators"}})
+}
+
+func runUnicodeNormalization(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateUnicodeNormalization,
+		Fix:              fixUnicodeNormalization,
+		PassMsg:          "all cells are NFC-normalized",
+		FixedMsg:         "cells normalized to NFC",
+		AppliedMsg:       "auto-fix applied: cells normalized to NFC",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+	})
+}
+
+// validateUnicodeNormalization reports cells that are not in Unicode NFC
+// form, such as decomposed accents ("e" + U+0301) written by macOS tools.
+// Such values look identical to their composed form but compare differently,
+// so a term that normalizes to another term's text is called out as well.
+func validateUnicodeNormalization(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for normalization"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping normalization)"}
+	}
+	termCol := tbl.Col("term")
+
+	// First row of each NFC term, to spot terms that differ only in form.
+	firstTerm := map[string]int{}
+	for _, row := range tbl.Rows {
+		t := norm.NFC.String(strings.TrimSpace(row.Get(termCol)))
+		if _, seen := firstTerm[t]; t != "" && !seen {
+			firstTerm[t] = row.Line
+		}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for c, v := range row.Cells {
+			if c >= len(tbl.Header) || norm.NFC.IsNormalString(v) {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			msg := "not in NFC form (decomposed characters)"
+			if c == termCol {
+				if other := firstTerm[norm.NFC.String(strings.TrimSpace(v))]; other != row.Line {
+					msg += fmt.Sprintf("; same as the term on row %d once normalized", other)
+				}
+			}
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, msg))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "all cells are NFC-normalized"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells not in NFC form: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// fixUnicodeNormalization rewrites every cell in NFC form. Terms that only
+// differed in form become plain duplicates; the note counts them so they can
+// be merged.
+func fixUnicodeNormalization(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.NoFix(a, "no parsable header")
+	}
+	termCol := tbl.Col("term")
+	cells := 0
+	out, changed, err := csvutil.Rewrite(a.Data, func(_ int, rec []string) bool {
+		edited := false
+		for c, v := range rec {
+			if !norm.NFC.IsNormalString(v) {
+				rec[c] = norm.NFC.String(v)
+				cells++
+				edited = true
+			}
+		}
+		return edited
+	})
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+
+	note := fmt.Sprintf("normalized %d cell(s)", cells)
+	if termCol >= 0 {
+		before, after := map[string]bool{}, map[string]bool{}
+		for _, row := range tbl.Rows {
+			t := strings.TrimSpace(row.Get(termCol))
+			before[t] = true
+			after[norm.NFC.String(t)] = true
+		}
+		if merged := len(before) - len(after); merged > 0 {
+			note += fmt.Sprintf("; %d term(s) now duplicate another term", merged)
+		}
+	}
+	return checks.FixResult{Data: out, DidChange: changed, Note: note}, nil
+}
@@ -0,0 +1,57 @@
+package unicode_normalization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunUnicodeNormalization(t *testing.T) {
+	// "cafe\u0301" is "café" with a combining acute accent.
+	a := checks.Artifact{
+		Data: []byte("term;description;fr\ncafé;composed;café\ncafe\u0301;decomposed;ok\nlogin;;re\u0301seau\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runUnicodeNormalization(ctx, a, checks.RunOptions{})
+	want := "cells not in NFC form: row 3 term, row 4 fr (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 2 {
+		t.Fatalf("got %d findings, want 2", len(fds))
+	}
+	if got := fds[0].Message; got != "not in NFC form (decomposed characters); same as the term on row 2 once normalized" {
+		t.Errorf("term finding = %q", got)
+	}
+	if got := fds[1].Message; got != "not in NFC form (decomposed characters)" {
+		t.Errorf("translation finding = %q", got)
+	}
+}
+
+func TestRunUnicodeNormalizationPasses(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term;description\ncafé;ok\n"), Path: "g.csv"}
+	out := runUnicodeNormalization(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("got %s %q, want PASS", out.Result.Status, out.Result.Message)
+	}
+}
+
+func TestFixUnicodeNormalization(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;fr\r\ncafé;composed;café\r\ncafe\u0301;decomposed;ok\r\nlogin;;re\u0301seau\r\n"),
+		Path: "g.csv",
+	}
+	out := runUnicodeNormalization(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	want := "term;description;fr\r\ncafé;composed;café\r\ncafé;decomposed;ok\r\nlogin;;réseau\r\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want {
+		t.Fatalf("got %s %q\n%q", out.Result.Status, out.Result.Message, out.Final.Data)
+	}
+	if got := out.Final.Note; got != "normalized 2 cell(s); 1 term(s) now duplicate another term" {
+		t.Errorf("note = %q", got)
+	}
+}
@@ -0,0 +1,143 @@
+package invisible_characters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-invisible-characters"
+
+// invisible names the characters reported by this check.
+var invisible = map[rune]string{
+	'\u00a0': "no-break space",
+	'\u00ad': "soft hyphen",
+	'\u200b': "zero-width space",
+	'\u200c': "zero-width non-joiner",
+	'\u200d': "zero-width joiner",
+	'\u2060': "word joiner",
+	'\u202f': "narrow no-break space",
+	'\ufeff': "zero-width no-break space (BOM)",
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runInvisibleCharacters,
+		checks.WithPriority(31),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-INVISIBLE", Remediation: checkmeta.Remediation{
+		Hint: "Delete the reported characters (or replace no-break spaces with regular spaces); they usually come from Word or Google Docs.",
+	}})
+}
+
+func runInvisibleCharacters(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateInvisibleCharacters,
+		Fix:      nil,
+		PassMsg:  "no invisible characters in cells",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateInvisibleCharacters reports zero-width characters, soft hyphens
+// and no-break spaces inside term, description and translation cells, with
+// the character position of each. Leading and trailing no-break spaces are
+// left to warn-cell-whitespace.
+func validateInvisibleCharacters(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for invisible characters"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping invisible characters)"}
+	}
+	var cols []int
+	for i, h := range tbl.Header {
+		n := csvutil.NormalizeHeader(h)
+		if n == "term" || n == "description" || strings.HasSuffix(n, "_description") {
+			cols = append(cols, i)
+		}
+	}
+	cols = append(cols, tbl.LangCols()...)
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			found := findInvisible(row.Get(c))
+			if len(found) == 0 {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, strings.Join(found, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no invisible characters in cells"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells with invisible characters: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// findInvisible describes each invisible character in s with its 1-based
+// character position, e.g. "U+200B zero-width space at position 4".
+// No-break spaces at either end and zero-width joiners inside emoji
+// sequences are not reported.
+func findInvisible(s string) []string {
+	rs := []rune(s)
+	first, last := 0, len(rs)
+	for first < last && unicode.IsSpace(rs[first]) {
+		first++
+	}
+	for last > first && unicode.IsSpace(rs[last-1]) {
+		last--
+	}
+
+	var out []string
+	for i, r := range rs {
+		name, ok := invisible[r]
+		if !ok {
+			continue
+		}
+		if unicode.IsSpace(r) && (i < first || i >= last) {
+			continue
+		}
+		if r == '\u200d' && i > 0 && i+1 < len(rs) && isEmojiPart(rs[i-1]) && isEmojiPart(rs[i+1]) {
+			continue
+		}
+		out = append(out, fmt.Sprintf("U+%04X %s at position %d", r, name, i+1))
+	}
+	return out
+}
+
+// isEmojiPart reports whether r can sit next to a joiner in an emoji
+// sequence (woman + joiner + laptop = woman technologist): a symbol or a
+// variation selector.
+func isEmojiPart(r rune) bool {
+	return unicode.Is(unicode.So, r) || r == '\ufe0f'
+}
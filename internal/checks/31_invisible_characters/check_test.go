@@ -0,0 +1,41 @@
+package invisible_characters
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFindInvisible(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"log in", nil},
+		{"log\u200bin", []string{"U+200B zero-width space at position 4"}},
+		{"co\u00adop\u00a0x", []string{"U+00AD soft hyphen at position 3", "U+00A0 no-break space at position 6"}},
+		{"\u00a0edge\u00a0", nil},
+		{"\ufeffterm", []string{"U+FEFF zero-width no-break space (BOM) at position 1"}},
+		{"\U0001F469\u200d\U0001F4BB", nil},
+		{"a\u200db", []string{"U+200D zero-width joiner at position 2"}},
+	}
+	for _, tc := range cases {
+		if got := findInvisible(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findInvisible(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRunInvisibleCharacters(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;tags;de\nlog\u200bin;ok;a\u200bb;anmelden\ncart;x\u00a0y;;ok\n"),
+		Path: "g.csv",
+	}
+	out := runInvisibleCharacters(context.Background(), a, checks.RunOptions{})
+	want := "cells with invisible characters: row 2 term, row 3 description (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
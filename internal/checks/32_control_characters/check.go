@@ -0,0 +1,104 @@
+package control_characters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "no-control-characters"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runControlCharacters,
+		checks.WithPriority(32),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-CONTROL-CHAR", Remediation: checkmeta.Remediation{
+		Hint: "Remove the reported control characters; they are never part of a term and break Lokalise imports.",
+	}})
+}
+
+func runControlCharacters(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateControlCharacters,
+		Fix:      nil,
+		PassMsg:  "no control characters in cells",
+		FailAs:   checks.Fail,
+	})
+}
+
+// validateControlCharacters fails on ASCII control characters in any cell,
+// header included. Tabs and line breaks are allowed here: line breaks are
+// valid inside quoted cells, and tabs are reported by warn-inner-whitespace.
+func validateControlCharacters(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for control characters"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping control characters)"}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	scan := func(line int, offset int64, cells []string) {
+		for c, v := range cells {
+			found := findControl(v)
+			if len(found) == 0 {
+				continue
+			}
+			col := fmt.Sprintf("column %d", c+1)
+			if c < len(tbl.Header) && line != tbl.HeaderLine {
+				col = strings.TrimSpace(tbl.Header[c])
+			}
+			bad = append(bad, fmt.Sprintf("row %d %s", line, col))
+			fds = append(fds, findings.At(line, col, offset, strings.Join(found, ", ")))
+		}
+	}
+	scan(tbl.HeaderLine, tbl.HeaderOffset, tbl.Header)
+	for _, row := range tbl.Rows {
+		scan(row.Line, row.Offset, row.Cells)
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no control characters in cells"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells with control characters: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// findControl describes each ASCII control character in s other than tab,
+// CR and LF, with its 1-based character position.
+func findControl(s string) []string {
+	var out []string
+	pos := 0
+	for _, r := range s {
+		pos++
+		if (r < 0x20 && r != '\t' && r != '\n' && r != '\r') || r == 0x7f {
+			out = append(out, fmt.Sprintf("U+%04X at position %d", r, pos))
+		}
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package control_characters
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFindControl(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"plain", nil},
+		{"tab\tand\r\nbreaks", nil},
+		{"bell\x07", []string{"U+0007 at position 5"}},
+		{"\x00é\x7f", []string{"U+0000 at position 1", "U+007F at position 3"}},
+	}
+	for _, tc := range cases {
+		if got := findControl(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findControl(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRunControlCharacters(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description\x1b;tags\nlogin;ok;a\x0cb\n\"multi\nline\";fine;\n"),
+		Path: "g.csv",
+	}
+	out := runControlCharacters(context.Background(), a, checks.RunOptions{})
+	want := "cells with control characters: row 1 column 2, row 2 tags (total 2)"
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+}
@@ -0,0 +1,155 @@
+package mixed_script
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-mixed-script"
+
+// scripts whose letters are easily confused with each other ("a" and "а",
+// "o" and "ο"). Other scripts are legitimately mixed with these (Japanese
+// brand names, for example) and are ignored.
+var scripts = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Latin", unicode.Latin},
+	{"Cyrillic", unicode.Cyrillic},
+	{"Greek", unicode.Greek},
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runMixedScript,
+		checks.WithPriority(33),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-MIXED-SCRIPT", Remediation: checkmeta.Remediation{
+		Hint: "Retype the reported characters in the script of the rest of the word; they are usually typed with the wrong keyboard layout.",
+	}})
+}
+
+func runMixedScript(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateMixedScript,
+		Fix:      nil,
+		PassMsg:  "no terms mix Latin, Cyrillic or Greek letters within a word",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateMixedScript warns about terms with a word mixing Latin, Cyrillic
+// and Greek letters, such as "lоgin" with a Cyrillic "о". Words in different
+// scripts side by side ("iPhone для") are fine.
+func validateMixedScript(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for mixed scripts"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping mixed scripts)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping mixed scripts)"}
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		term := row.Get(termCol)
+		found := findMixed(term)
+		if len(found) == 0 {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("%q (row %d)", strings.TrimSpace(term), row.Line))
+		fds = append(fds, findings.At(row.Line, termName, row.Offset, strings.Join(found, ", ")))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no terms mix Latin, Cyrillic or Greek letters within a word"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms mixing scripts within a word: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// findMixed describes the odd letters of each word in s that mixes scripts:
+// letters outside the word's majority script (the first of scripts on a
+// tie), with their 1-based character positions in s.
+func findMixed(s string) []string {
+	rs := []rune(s)
+	var out []string
+	for start := 0; start < len(rs); {
+		if !isWordRune(rs[start]) {
+			start++
+			continue
+		}
+		end := start
+		counts := make([]int, len(scripts))
+		for end < len(rs) && isWordRune(rs[end]) {
+			if i := scriptOf(rs[end]); i >= 0 {
+				counts[i]++
+			}
+			end++
+		}
+		major, used := 0, 0
+		for i, n := range counts {
+			if n > 0 {
+				used++
+			}
+			if n > counts[major] {
+				major = i
+			}
+		}
+		if used > 1 {
+			word := string(rs[start:end])
+			for i := start; i < end; i++ {
+				if sc := scriptOf(rs[i]); sc >= 0 && sc != major {
+					out = append(out, fmt.Sprintf("%s %q (U+%04X) at position %d in %s word %q",
+						scripts[sc].name, rs[i], rs[i], i+1, scripts[major].name, word))
+				}
+			}
+		}
+		start = end
+	}
+	return out
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// scriptOf returns the index in scripts of r's script, or -1.
+func scriptOf(r rune) int {
+	for i, sc := range scripts {
+		if unicode.Is(sc.table, r) {
+			return i
+		}
+	}
+	return -1
+}
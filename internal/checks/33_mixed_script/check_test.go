@@ -0,0 +1,40 @@
+package mixed_script
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFindMixed(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"login", nil},
+		{"iPhone для", nil},
+		{"l\u043egin", []string{"Cyrillic 'о' (U+043E) at position 2 in Latin word \"l\u043egin\""}},
+		{"привет pри", []string{"Latin 'p' (U+0070) at position 8 in Cyrillic word \"pри\""}},
+		{"αlpha", []string{`Greek 'α' (U+03B1) at position 1 in Latin word "αlpha"`}},
+		{"T恤", nil},
+	}
+	for _, tc := range cases {
+		if got := findMixed(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findMixed(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRunMixedScript(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description\nl\u043egin;x\nlogin;\u043e\n"),
+		Path: "g.csv",
+	}
+	out := runMixedScript(context.Background(), a, checks.RunOptions{})
+	want := "terms mixing scripts within a word: \"l\u043egin\" (row 2) (total 1)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
@@ -0,0 +1,258 @@
+package typography
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-typography"
+
+// curly maps typographic quotes and dashes to their straight counterparts.
+var curly = map[rune]struct{ name, straight string }{
+	'‘': {"left single quote", "'"},
+	'’': {"curly apostrophe", "'"},
+	'‚': {"low single quote", "'"},
+	'“': {"left double quote", `"`},
+	'”': {"right double quote", `"`},
+	'„': {"low double quote", `"`},
+	'–': {"en dash", "-"},
+	'—': {"em dash", "-"},
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runTypography,
+		checks.WithPriority(34),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-TYPOGRAPHY", Remediation: checkmeta.Remediation{
+		Hint: "Use the configured quote and dash style in the reported terms, or run with --fix.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}, After: []string{"ensure-semicolon-separators"}})
+}
+
+func runTypography(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateTypography,
+		Fix:              fixTypography,
+		FixedMsg:         "quotes and dashes follow the configured style",
+		AppliedMsg:       "auto-fix applied: replaced quotes and dashes",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+	})
+}
+
+// columns returns the columns the check covers: the term and, with
+// translations: true, the language columns.
+func columns(tbl *csvutil.Table) (cols []int, what string) {
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return nil, ""
+	}
+	cols = []int{termCol}
+	if config.Get().Checks.Typography.Translations {
+		return append(cols, tbl.LangCols()...), "terms and translations"
+	}
+	return cols, "terms"
+}
+
+// validateTypography warns about terms (and optionally translations) whose
+// quotes, apostrophes and dashes do not follow the configured style.
+func validateTypography(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	style := config.Get().Checks.Typography.Style
+	if style == "" {
+		return checks.ValidationResult{OK: true, Msg: "no typography style configured (skipping)"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for typography"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping typography)"}
+	}
+	cols, what := columns(tbl)
+	if cols == nil {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping typography)"}
+	}
+
+	check := findTypographic
+	if style == "typographic" {
+		check = findStraight
+	}
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for i, c := range cols {
+			cell := strings.TrimSpace(row.Get(c))
+			found := check(cell)
+			if len(found) == 0 {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			if i == 0 {
+				bad = append(bad, fmt.Sprintf("%q (row %d)", cell, row.Line))
+			} else {
+				bad = append(bad, fmt.Sprintf("%q (row %d %s)", cell, row.Line, col))
+			}
+			fds = append(fds, findings.At(row.Line, col, row.Offset, strings.Join(found, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "all " + what + " use " + style + " quotes and dashes"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: what + " not using " + style + " quotes and dashes: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// fixTypography rewrites the covered cells in the configured style.
+func fixTypography(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.NoFix(a, "no parsable header")
+	}
+	cols, _ := columns(tbl)
+	if cols == nil {
+		return checks.NoFix(a, "no 'term' column found")
+	}
+	convert := toStraight
+	if config.Get().Checks.Typography.Style == "typographic" {
+		convert = toTypographic
+	}
+
+	n := 0
+	out, changed, err := csvutil.Rewrite(a.Data, func(line int, rec []string) bool {
+		if line <= tbl.HeaderLine {
+			return false
+		}
+		edited := false
+		for _, c := range cols {
+			if c >= len(rec) {
+				continue
+			}
+			if s := convert(rec[c]); s != rec[c] {
+				rec[c] = s
+				edited = true
+				n++
+			}
+		}
+		return edited
+	})
+	if err != nil {
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if !changed {
+		return checks.NoFix(a, "nothing to replace")
+	}
+	return checks.FixResult{
+		Data:      out,
+		Path:      a.Path,
+		DidChange: true,
+		Note:      fmt.Sprintf("replaced quotes and dashes in %d cell(s)", n),
+	}, nil
+}
+
+// findTypographic lists curly quotes and long dashes in s for the straight
+// style, with their 1-based character positions.
+func findTypographic(s string) []string {
+	var out []string
+	for i, r := range []rune(s) {
+		if c, ok := curly[r]; ok {
+			out = append(out, fmt.Sprintf("%s %c at position %d (use %s)", c.name, r, i+1, c.straight))
+		}
+	}
+	return out
+}
+
+// toStraight replaces the characters findTypographic reports.
+func toStraight(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if c, ok := curly[r]; ok {
+			b.WriteString(c.straight)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// toTypographic replaces the characters findStraight reports. A quote
+// opens at the start or after a space, bracket or dash and closes
+// elsewhere, so an apostrophe inside a word becomes ’.
+func toTypographic(s string) string {
+	rs := []rune(s)
+	var b strings.Builder
+	for i := 0; i < len(rs); i++ {
+		opening := i == 0 || strings.ContainsRune(" \t([{“‘–—-", rs[i-1])
+		switch r := rs[i]; {
+		case r == '\'' && opening:
+			b.WriteRune('‘')
+		case r == '\'':
+			b.WriteRune('’')
+		case r == '"' && opening:
+			b.WriteRune('“')
+		case r == '"':
+			b.WriteRune('”')
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			b.WriteRune('—')
+			for i+1 < len(rs) && rs[i+1] == '-' {
+				i++
+			}
+		case r == '-' && i > 0 && i+1 < len(rs) && rs[i-1] == ' ' && rs[i+1] == ' ':
+			b.WriteRune('–')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// findStraight lists straight quotes and hyphens standing in for dashes
+// ("--", or "-" between spaces) in s for the typographic style.
+func findStraight(s string) []string {
+	rs := []rune(s)
+	var out []string
+	for i := 0; i < len(rs); i++ {
+		switch r := rs[i]; {
+		case r == '\'':
+			out = append(out, fmt.Sprintf("straight apostrophe ' at position %d (use ’ or ‘)", i+1))
+		case r == '"':
+			out = append(out, fmt.Sprintf("straight double quote \" at position %d (use “ or ”)", i+1))
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			out = append(out, fmt.Sprintf("double hyphen -- at position %d (use —)", i+1))
+			for i+1 < len(rs) && rs[i+1] == '-' {
+				i++
+			}
+		case r == '-' && i > 0 && i+1 < len(rs) && rs[i-1] == ' ' && rs[i+1] == ' ':
+			out = append(out, fmt.Sprintf("spaced hyphen at position %d (use –)", i+1))
+		}
+	}
+	return out
+}
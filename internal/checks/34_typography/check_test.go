@@ -0,0 +1,94 @@
+package typography
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestFindTypographic(t *testing.T) {
+	got := findTypographic("don’t “stop” — go")
+	want := []string{
+		"curly apostrophe ’ at position 4 (use ')",
+		"left double quote “ at position 7 (use \")",
+		"right double quote ” at position 12 (use \")",
+		"em dash — at position 14 (use -)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q\nwant %q", got, want)
+	}
+	if got := findTypographic("log-in"); got != nil {
+		t.Fatalf("plain term reported: %q", got)
+	}
+}
+
+func TestFindStraight(t *testing.T) {
+	got := findStraight(`don't "stop" -- go - now`)
+	want := []string{
+		"straight apostrophe ' at position 4 (use ’ or ‘)",
+		"straight double quote \" at position 7 (use “ or ”)",
+		"straight double quote \" at position 12 (use “ or ”)",
+		"double hyphen -- at position 14 (use —)",
+		"spaced hyphen at position 20 (use –)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q\nwant %q", got, want)
+	}
+	if got := findStraight("log-in"); got != nil {
+		t.Fatalf("hyphenated term reported: %q", got)
+	}
+}
+
+func TestRunTypography(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description\ndon’t;x\nlogin;it's fine here\n"),
+		Path: "g.csv",
+	}
+
+	out := runTypography(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("unconfigured: got %s %q, want PASS", out.Result.Status, out.Result.Message)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{Typography: config.Typography{Style: "straight"}}})
+	t.Cleanup(func() { config.Set(nil) })
+	out = runTypography(context.Background(), a, checks.RunOptions{})
+	want := "terms not using straight quotes and dashes: \"don’t\" (row 2) (total 1)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	if got := toStraight("don’t „stop” — go"); got != `don't "stop" - go` {
+		t.Errorf("toStraight: %q", got)
+	}
+	if got := toTypographic(`don't "stop" -- say 'hi' - now`); got != "don’t “stop” — say ‘hi’ – now" {
+		t.Errorf("toTypographic: %q", got)
+	}
+	if got := toTypographic("log-in"); got != "log-in" {
+		t.Errorf("hyphen changed: %q", got)
+	}
+}
+
+func TestFixTypography(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{Typography: config.Typography{Style: "straight", Translations: true}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;de\ndon’t;it’s kept;nicht – so\nlogin;x;Anmeldung\n"),
+		Path: "g.csv",
+	}
+	out := runTypography(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	want := "term;description;de\ndon't;it’s kept;nicht - so\nlogin;x;Anmeldung\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want {
+		t.Fatalf("got %s %q\n%q", out.Result.Status, out.Result.Message, out.Final.Data)
+	}
+	if out.Final.Note != "replaced quotes and dashes in 2 cell(s)" {
+		t.Errorf("note %q", out.Final.Note)
+	}
+}
@@ -0,0 +1,127 @@
+package line_endings
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-line-endings"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runLineEndings,
+		checks.WithPriority(35),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-LINE-ENDINGS", Remediation: checkmeta.Remediation{
+		Hint: "Convert the file to a single line ending style, e.g. with `dos2unix` or your editor's line ending setting.",
+	}})
+}
+
+func runLineEndings(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateLineEndings,
+		Fix:      nil,
+		PassMsg:  "line endings are consistent",
+		FailAs:   checks.Warn,
+	})
+}
+
+// ending is one line terminator found in the data.
+type ending struct {
+	kind   string // "CRLF", "LF" or "CR"
+	line   int    // 1-based line it terminates, counting LF-terminated lines
+	offset int64  // start of that line
+}
+
+// validateLineEndings warns when the file mixes CRLF and LF line endings
+// or has lone CR endings. The less common of CRLF and LF is reported line
+// by line; lone CRs are always reported.
+func validateLineEndings(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for line endings"}
+	}
+
+	ends := scanEndings(a.Data)
+	count := map[string]int{}
+	for _, e := range ends {
+		count[e.kind]++
+	}
+	main := "LF"
+	if count["CRLF"] > count["LF"] {
+		main = "CRLF"
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, e := range ends {
+		if e.kind == main {
+			continue
+		}
+		msg := e.kind + " line ending (the file mostly uses " + main + ")"
+		if e.kind == "CR" {
+			msg = "lone CR line ending"
+		}
+		bad = append(bad, strconv.Itoa(e.line))
+		fds = append(fds, findings.At(e.line, "", e.offset, msg))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "line endings are consistent"}
+	}
+	var kinds string
+	switch {
+	case count["CR"] > 0 && count["CRLF"] > 0 && count["LF"] > 0:
+		kinds = "mixed CRLF/LF and lone CR"
+	case count["CR"] > 0:
+		kinds = "lone CR"
+	default:
+		kinds = "mixed CRLF/LF"
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: fmt.Sprintf("%s line endings (mostly %s); lines: %s", kinds, main, csvutil.JoinLimited(bad, ", ", 10)),
+	}
+}
+
+// scanEndings returns every line terminator in data. Lines are numbered the
+// way the other checks number them: a lone CR does not start a new line.
+func scanEndings(data []byte) []ending {
+	var ends []ending
+	line, start := 1, int64(0)
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '\n':
+			ends = append(ends, ending{kind: "LF", line: line, offset: start})
+			line, start = line+1, int64(i+1)
+		case '\r':
+			if i+1 < len(data) && data[i+1] == '\n' {
+				ends = append(ends, ending{kind: "CRLF", line: line, offset: start})
+				i++
+				line, start = line+1, int64(i+1)
+				continue
+			}
+			ends = append(ends, ending{kind: "CR", line: line, offset: start})
+		}
+	}
+	return ends
+}
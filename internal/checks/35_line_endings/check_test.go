@@ -0,0 +1,43 @@
+package line_endings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunLineEndings(t *testing.T) {
+	cases := []struct {
+		name   string
+		data   string
+		status checks.Status
+		msg    string
+	}{
+		{"lf", "term;description\na;b\nc;d\n", checks.Pass, "line endings are consistent"},
+		{"crlf", "term;description\r\na;b\r\nc;d", checks.Pass, "line endings are consistent"},
+		{"mixed", "term;description\r\na;b\nc;d\r\ne;f\r\n", checks.Warn, "mixed CRLF/LF line endings (mostly CRLF); lines: 2 (total 1)"},
+		{"lone cr", "term;description\na;b\rc;d\n", checks.Warn, "lone CR line endings (mostly LF); lines: 2 (total 1)"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := checks.Artifact{Data: []byte(tc.data), Path: "g.csv"}
+			out := runLineEndings(context.Background(), a, checks.RunOptions{})
+			if out.Result.Status != tc.status || out.Result.Message != tc.msg {
+				t.Fatalf("got %s %q\nwant %s %q", out.Result.Status, out.Result.Message, tc.status, tc.msg)
+			}
+		})
+	}
+}
+
+func TestLineEndingFindings(t *testing.T) {
+	ctx, col := findings.WithCollector(context.Background())
+	a := checks.Artifact{Data: []byte("term;description\na;b\r\nc;d\n"), Path: "g.csv"}
+	runLineEndings(ctx, a, checks.RunOptions{})
+	fds := col.Findings()
+	if len(fds) != 1 || fds[0].Row != 2 || fds[0].Offset != 17 || fds[0].Message != "CRLF line ending (the file mostly uses LF)" {
+		t.Fatalf("got %+v", fds)
+	}
+}
@@ -0,0 +1,92 @@
+package bom_policy
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "bom-policy"
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runBOMPolicy,
+		checks.WithPriority(36),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-BOM", Remediation: checkmeta.Remediation{
+		Hint: "Save the file as \"UTF-8 with BOM\" or plain \"UTF-8\", as the configured BOM policy requires, or run with --fix.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runBOMPolicy(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateBOMPolicy,
+		Fix:              fixBOMPolicy,
+		FixedMsg:         "UTF-8 BOM adjusted to the policy",
+		AppliedMsg:       "auto-fix applied: UTF-8 BOM adjusted to the policy",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Fail,
+	})
+}
+
+// validateBOMPolicy fails when the file has a UTF-8 BOM under forbid-bom or
+// lacks one under require-bom.
+func validateBOMPolicy(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	policy := config.Get().Checks.BOM.Policy
+	if policy == "" || policy == "allow" {
+		return checks.ValidationResult{OK: true, Msg: "no BOM policy configured (skipping)"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for BOM"}
+	}
+
+	has := bytes.HasPrefix(a.Data, utf8BOM)
+	switch {
+	case policy == "require-bom" && !has:
+		findings.Report(ctx, []findings.Finding{findings.At(1, "", 0, "file does not start with a UTF-8 BOM")})
+		return checks.ValidationResult{OK: false, Msg: "UTF-8 BOM required by policy but missing"}
+	case policy == "forbid-bom" && has:
+		findings.Report(ctx, []findings.Finding{findings.At(1, "", 0, "file starts with a UTF-8 BOM")})
+		return checks.ValidationResult{OK: false, Msg: "UTF-8 BOM forbidden by policy but present"}
+	case has:
+		return checks.ValidationResult{OK: true, Msg: "UTF-8 BOM present as required"}
+	}
+	return checks.ValidationResult{OK: true, Msg: "no UTF-8 BOM, as required"}
+}
+
+// fixBOMPolicy prepends a UTF-8 BOM under require-bom and strips it under
+// forbid-bom.
+func fixBOMPolicy(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	policy := config.Get().Checks.BOM.Policy
+	has := bytes.HasPrefix(a.Data, utf8BOM)
+	switch {
+	case policy == "require-bom" && !has:
+		out := append(append([]byte{}, utf8BOM...), a.Data...)
+		return checks.FixResult{Data: out, DidChange: true, Note: "added UTF-8 BOM"}, nil
+	case policy == "forbid-bom" && has:
+		return checks.FixResult{Data: a.Data[len(utf8BOM):], DidChange: true, Note: "removed UTF-8 BOM"}, nil
+	}
+	return checks.FixResult{Data: a.Data, Note: "BOM already matches the policy"}, nil
+}
@@ -0,0 +1,57 @@
+package bom_policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestRunBOMPolicy(t *testing.T) {
+	withBOM := "\ufeffterm;description\na;b\n"
+	without := "term;description\na;b\n"
+	cases := []struct {
+		policy string
+		data   string
+		status checks.Status
+		msg    string
+	}{
+		{"", withBOM, checks.Pass, "no BOM policy configured (skipping)"},
+		{"allow", without, checks.Pass, "no BOM policy configured (skipping)"},
+		{"require-bom", withBOM, checks.Pass, "UTF-8 BOM present as required"},
+		{"require-bom", without, checks.Fail, "UTF-8 BOM required by policy but missing"},
+		{"forbid-bom", without, checks.Pass, "no UTF-8 BOM, as required"},
+		{"forbid-bom", withBOM, checks.Fail, "UTF-8 BOM forbidden by policy but present"},
+	}
+	t.Cleanup(func() { config.Set(nil) })
+	for _, tc := range cases {
+		config.Set(&config.Config{Checks: config.Checks{BOM: config.BOM{Policy: tc.policy}}})
+		a := checks.Artifact{Data: []byte(tc.data), Path: "g.csv"}
+		out := runBOMPolicy(context.Background(), a, checks.RunOptions{})
+		if out.Result.Status != tc.status || out.Result.Message != tc.msg {
+			t.Errorf("%s: got %s %q, want %s %q", tc.policy, out.Result.Status, out.Result.Message, tc.status, tc.msg)
+		}
+	}
+}
+
+func TestFixBOMPolicy(t *testing.T) {
+	withBOM := "\ufeffterm;description\na;b\n"
+	without := "term;description\na;b\n"
+	cases := []struct {
+		policy, data, fixed, note string
+	}{
+		{"require-bom", without, withBOM, "added UTF-8 BOM"},
+		{"forbid-bom", withBOM, without, "removed UTF-8 BOM"},
+	}
+	t.Cleanup(func() { config.Set(nil) })
+	for _, tc := range cases {
+		config.Set(&config.Config{Checks: config.Checks{BOM: config.BOM{Policy: tc.policy}}})
+		a := checks.Artifact{Data: []byte(tc.data), Path: "g.csv"}
+		out := runBOMPolicy(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed, RerunAfterFix: true})
+		if out.Result.Status != checks.Pass || !out.Final.DidChange || string(out.Final.Data) != tc.fixed || out.Final.Note != tc.note {
+			t.Errorf("%s: got %s %q, data %q, note %q", tc.policy, out.Result.Status, out.Result.Message, out.Final.Data, out.Final.Note)
+		}
+	}
+}
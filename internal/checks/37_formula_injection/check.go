@@ -0,0 +1,87 @@
+package formula_injection
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-formula-injection"
+
+// triggers are the leading characters that make spreadsheets evaluate a
+// cell as a formula (OWASP "CSV Injection").
+const triggers = "=+-@\t\r"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runFormulaInjection,
+		checks.WithPriority(37),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-FORMULA", Remediation: checkmeta.Remediation{
+		Hint: "Prefix the reported cells with a single quote (') or reword them so they do not start with =, +, - or @.",
+	}})
+}
+
+func runFormulaInjection(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateFormulaInjection,
+		Fix:      nil,
+		PassMsg:  "no cells that spreadsheets would run as formulas",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateFormulaInjection reports cells starting with a character that
+// Excel or Google Sheets treat as the start of a formula. A cell escaped
+// with a leading single quote is fine.
+func validateFormulaInjection(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for formula injection"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping formula injection)"}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for c, v := range row.Cells {
+			if c >= len(tbl.Header) || v == "" || !strings.ContainsRune(triggers, rune(v[0])) {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset,
+				fmt.Sprintf("starts with %q and would be evaluated as a formula in a spreadsheet", v[:1])))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no cells that spreadsheets would run as formulas"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells starting with a formula character: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
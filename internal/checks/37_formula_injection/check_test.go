@@ -0,0 +1,26 @@
+package formula_injection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunFormulaInjection(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;de\n=HYPERLINK(\"x\");ok;ok\nlogin;@SUM(A1);'=escaped\ncart;a-b;-10\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runFormulaInjection(ctx, a, checks.RunOptions{})
+	want := "cells starting with a formula character: row 2 term, row 3 description, row 4 de (total 3)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	if got := col.Findings()[1].Message; got != `starts with "@" and would be evaluated as a formula in a spreadsheet` {
+		t.Errorf("finding = %q", got)
+	}
+}
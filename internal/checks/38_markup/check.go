@@ -0,0 +1,114 @@
+package markup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-markup"
+
+// patterns recognize markup, in the order findings list it.
+var patterns = []struct {
+	what string
+	re   *regexp.Regexp
+}{
+	{"HTML tag", regexp.MustCompile(`</?[A-Za-z][A-Za-z0-9-]*(\s[^<>]*)?/?>`)},
+	{"HTML comment", regexp.MustCompile(`<!--`)},
+	{"Markdown link", regexp.MustCompile(`\[[^\]]+\]\([^)\s]+\)`)},
+	{"Markdown bold", regexp.MustCompile(`\*\*[^*]+\*\*|__[^_]+__`)},
+	{"Markdown code", regexp.MustCompile("`[^`]+`")},
+	{"Markdown heading", regexp.MustCompile(`^#{1,6}\s`)},
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runMarkup,
+		checks.WithPriority(38),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-MARKUP", Remediation: checkmeta.Remediation{
+		Hint: "Keep only the term itself: strip HTML and Markdown, which usually means a whole UI string was pasted.",
+	}})
+}
+
+func runMarkup(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateMarkup,
+		Fix:      nil,
+		PassMsg:  "no HTML or Markdown in terms and translations",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateMarkup warns about terms and translations containing HTML tags or
+// Markdown markup. Descriptions may legitimately quote markup and are not
+// checked.
+func validateMarkup(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for markup"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping markup)"}
+	}
+	var cols []int
+	if c := tbl.Col("term"); c >= 0 {
+		cols = append(cols, c)
+	}
+	cols = append(cols, tbl.LangCols()...)
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			found := findMarkup(strings.TrimSpace(row.Get(c)))
+			if len(found) == 0 {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, strings.Join(found, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no HTML or Markdown in terms and translations"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells with HTML or Markdown: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// findMarkup describes the first match of each markup pattern in s, e.g.
+// `HTML tag "<b>"`.
+func findMarkup(s string) []string {
+	var out []string
+	for _, p := range patterns {
+		if m := p.re.FindString(s); m != "" {
+			out = append(out, fmt.Sprintf("%s %q", p.what, m))
+		}
+	}
+	return out
+}
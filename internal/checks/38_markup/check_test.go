@@ -0,0 +1,43 @@
+package markup
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFindMarkup(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"log in", nil},
+		{"a < b > c", nil},
+		{"x**2", nil},
+		{"<b>Save</b> changes", []string{`HTML tag "<b>"`}},
+		{`Click <a href="/x">here</a>`, []string{`HTML tag "<a href=\"/x\">"`}},
+		{"line<br/>break", []string{`HTML tag "<br/>"`}},
+		{"see [docs](https://x.io)", []string{`Markdown link "[docs](https://x.io)"`}},
+		{"**Bold** and `code`", []string{`Markdown bold "**Bold**"`, "Markdown code \"`code`\""}},
+		{"# Title", []string{`Markdown heading "# "`}},
+	}
+	for _, tc := range cases {
+		if got := findMarkup(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findMarkup(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRunMarkup(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;de\n<b>Save</b>;ok;ok\nlogin;use <b>login</b>;**anmelden**\n"),
+		Path: "g.csv",
+	}
+	out := runMarkup(context.Background(), a, checks.RunOptions{})
+	want := "cells with HTML or Markdown: row 2 term, row 3 de (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
@@ -0,0 +1,117 @@
+package untranslated
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-untranslated"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runUntranslated,
+		checks.WithPriority(39),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-UNTRANSLATED", Remediation: checkmeta.Remediation{
+		Hint: "Translate the reported cells, mark the term translatable=no, or list the language under ignore-languages.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runUntranslated(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateUntranslated,
+		Fix:      nil,
+		PassMsg:  "no translations identical to their term",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateUntranslated warns about translations identical to the term on
+// translatable terms, which usually means the source was copied and never
+// translated. Rows without a translatable column count as translatable, as
+// they do in Lokalise; languages listed under ignore-languages are skipped.
+func validateUntranslated(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for untranslated terms"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping untranslated terms)"}
+	}
+	termCol, flagCol := tbl.Col("term"), tbl.Col("translatable")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping untranslated terms)"}
+	}
+	var langCols []int
+	for _, c := range tbl.LangCols() {
+		if !ignored(config.Get().Checks.Untranslated, strings.TrimSpace(tbl.Header[c])) {
+			langCols = append(langCols, c)
+		}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		if flagCol >= 0 && strings.ToLower(strings.TrimSpace(row.Get(flagCol))) != "yes" {
+			continue
+		}
+		term := strings.TrimSpace(row.Get(termCol))
+		if term == "" {
+			continue
+		}
+		var langs []string
+		for _, c := range langCols {
+			if strings.TrimSpace(row.Get(c)) != term {
+				continue
+			}
+			lang := strings.TrimSpace(tbl.Header[c])
+			langs = append(langs, lang)
+			fds = append(fds, findings.At(row.Line, lang, row.Offset, "translation is identical to the term"))
+		}
+		if len(langs) > 0 {
+			bad = append(bad, fmt.Sprintf("%q (row %d: %s)", term, row.Line, strings.Join(langs, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no translations identical to their term"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "translations identical to the term: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
+
+// ignored reports whether lang is listed under ignore-languages
+// (case-insensitively).
+func ignored(cfg config.Untranslated, lang string) bool {
+	for _, l := range cfg.IgnoreLanguages {
+		if strings.EqualFold(strings.TrimSpace(l), lang) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package untranslated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestRunUntranslated(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;translatable;de;nl\n" +
+			"Dashboard;x;yes;Dashboard;Dashboard\n" +
+			"Lokalise;x;no;Lokalise;Lokalise\n" +
+			"login;x;yes;anmelden;\n"),
+		Path: "g.csv",
+	}
+
+	out := runUntranslated(context.Background(), a, checks.RunOptions{})
+	want := `translations identical to the term: "Dashboard" (row 2: de, nl) (total 1)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{Untranslated: config.Untranslated{IgnoreLanguages: []string{"NL"}}}})
+	t.Cleanup(func() { config.Set(nil) })
+	out = runUntranslated(context.Background(), a, checks.RunOptions{})
+	want = `translations identical to the term: "Dashboard" (row 2: de) (total 1)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestRunUntranslatedWithoutFlagColumn(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term;description;de\nAPI;x;API\n"), Path: "g.csv"}
+	out := runUntranslated(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("got %s %q, want WARN", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,69 @@
+package term_count
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+)
+
+const checkName = "term-count"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runTermCount,
+		checks.WithPriority(40),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-TERM-COUNT", Remediation: checkmeta.Remediation{
+		Hint: "Split the glossary into several files or remove unused terms before it reaches max_terms, which Lokalise will not import past.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+// runTermCount warns from the soft threshold on, ahead of the Lokalise
+// maximum (lokalise-limits max_terms); going over the maximum itself fails
+// lokalise-limits. It builds the outcome itself because the message depends
+// on the count.
+func runTermCount(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	if err := ctx.Err(); err != nil {
+		return checks.OutcomeKeep(checks.Error, checkName, "validation cancelled", a, "")
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no content to count terms", a, "")
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no parsable header (skipping term count)", a, "")
+	}
+
+	cfg := config.Get()
+	limit := cfg.Limits().MaxTerms
+	warnAt := cfg.Checks.TermCount.WarnAt
+	if warnAt == 0 {
+		warnAt = limit * 9 / 10
+	}
+
+	n := len(tbl.Rows)
+	switch {
+	case n > limit:
+		// lokalise-limits fails the file; warning here too would only repeat it.
+		return checks.OutcomeKeep(checks.Pass, checkName,
+			fmt.Sprintf("%d terms, over the Lokalise maximum of %d (see lokalise-limits)", n, limit), a, "")
+	case n >= warnAt:
+		return checks.OutcomeKeep(checks.Warn, checkName,
+			fmt.Sprintf("%d terms, close to the Lokalise maximum of %d (warning from %d)", n, limit, warnAt), a, "")
+	}
+	return checks.OutcomeKeep(checks.Pass, checkName, fmt.Sprintf("%d terms, within the Lokalise maximum of %d", n, limit), a, "")
+}
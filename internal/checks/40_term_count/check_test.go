@@ -0,0 +1,59 @@
+package term_count
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+)
+
+func glossary(terms int) []byte {
+	var b strings.Builder
+	b.WriteString("term;description\n")
+	for i := range terms {
+		b.WriteString("t")
+		b.WriteString(strings.Repeat("x", i))
+		b.WriteString(";d\n")
+	}
+	return []byte(b.String())
+}
+
+func TestRunTermCount(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{LokaliseLimits: lokalise.Limits{MaxTerms: 10}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	cases := []struct {
+		terms  int
+		status checks.Status
+		msg    string
+	}{
+		{8, checks.Pass, "8 terms, within the Lokalise maximum of 10"},
+		{9, checks.Warn, "9 terms, close to the Lokalise maximum of 10 (warning from 9)"},
+		{10, checks.Warn, "10 terms, close to the Lokalise maximum of 10 (warning from 9)"},
+		{12, checks.Pass, "12 terms, over the Lokalise maximum of 10 (see lokalise-limits)"},
+	}
+	for _, tc := range cases {
+		a := checks.Artifact{Data: glossary(tc.terms), Path: "g.csv"}
+		out := runTermCount(context.Background(), a, checks.RunOptions{})
+		if out.Result.Status != tc.status || out.Result.Message != tc.msg {
+			t.Errorf("%d terms: got %s %q, want %s %q", tc.terms, out.Result.Status, out.Result.Message, tc.status, tc.msg)
+		}
+	}
+}
+
+func TestRunTermCountWarnAt(t *testing.T) {
+	config.Set(&config.Config{Checks: config.Checks{
+		LokaliseLimits: lokalise.Limits{MaxTerms: 2},
+		TermCount:      config.TermCount{WarnAt: 1},
+	}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	out := runTermCount(context.Background(), checks.Artifact{Data: glossary(1), Path: "g.csv"}, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("warn-at 1: got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,87 @@
+package column_count
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+// checkName sorts after ensure-at-least-two-lines, so with the same
+// priority this check runs between it and ensure-semicolon-separators.
+const checkName = "ensure-consistent-columns"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runColumnCount,
+		// Before ensure-semicolon-separators (6), which stops the run on a
+		// ragged file without saying which rows are off.
+		checks.WithPriority(5),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-COLUMN-COUNT", Remediation: checkmeta.Remediation{
+		Hint: "Give every row as many fields as the header: add missing semicolons, and quote values that contain one.",
+	}})
+}
+
+func runColumnCount(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateColumnCount,
+		Fix:      nil,
+		PassMsg:  "every row has as many fields as the header",
+		FailAs:   checks.Fail,
+	})
+}
+
+// validateColumnCount reports every row whose number of semicolon-separated
+// fields differs from the header's. Files that are not semicolon-separated
+// at all are left to ensure-semicolon-separators.
+func validateColumnCount(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for column count"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping column count)"}
+	}
+	width := len(tbl.Header)
+	if width < 2 {
+		return checks.ValidationResult{OK: true, Msg: "header is not semicolon-separated (skipping column count)"}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		n := len(row.Cells)
+		if n == width {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("row %d (%d)", row.Line, n))
+		fds = append(fds, findings.At(row.Line, "", row.Offset, fmt.Sprintf("%d fields, header has %d", n, width)))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "every row has as many fields as the header"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: fmt.Sprintf("rows with a different number of fields than the header (%d): %s", width, csvutil.JoinLimited(bad, ", ", 10)),
+	}
+}
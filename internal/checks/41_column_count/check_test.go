@@ -0,0 +1,54 @@
+package column_count
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/5_at_least_two_lines"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/6_semicolon_separators"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunColumnCount(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;tags\nlogin;ok;a\ncart;x\n\"a;b\";quoted;ok\nfoo;d;t;extra\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runColumnCount(ctx, a, checks.RunOptions{})
+	want := "rows with a different number of fields than the header (3): row 3 (2), row 5 (4) (total 2)"
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+	if fds := col.Findings(); len(fds) != 2 || fds[0].Message != "2 fields, header has 3" {
+		t.Fatalf("findings = %+v", fds)
+	}
+}
+
+func TestRunColumnCountSkipsOtherSeparators(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term,description\nlogin,ok;x\n"), Path: "g.csv"}
+	out := runColumnCount(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("got %s %q, want PASS", out.Result.Status, out.Result.Message)
+	}
+}
+
+func TestRunsBeforeSeparatorCheck(t *testing.T) {
+	var order []string
+	for _, c := range checks.ListSorted() {
+		order = append(order, c.Name())
+	}
+	pos := func(name string) int {
+		for i, n := range order {
+			if n == name {
+				return i
+			}
+		}
+		return -1
+	}
+	if !(pos("ensure-at-least-two-lines") < pos(checkName) && pos(checkName) < pos("ensure-semicolon-separators")) {
+		t.Fatalf("%s does not run between the line count and separator checks: %v", checkName, order)
+	}
+}
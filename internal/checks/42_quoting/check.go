@@ -0,0 +1,208 @@
+package quoting
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "ensure-valid-quoting"
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runQuoting,
+		// Before ensure-semicolon-separators (6), like ensure-consistent-columns:
+		// broken quotes are a common reason for rows of uneven width.
+		checks.WithPriority(5),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-QUOTING", Remediation: checkmeta.Remediation{
+		Hint: "Wrap values containing quotes, semicolons or line breaks in double quotes, and double every quote inside them.",
+	}})
+}
+
+func runQuoting(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateQuoting,
+		Fix:      nil,
+		PassMsg:  "all values are correctly quoted",
+		FailAs:   checks.Fail,
+	})
+}
+
+// problem is a field with broken quoting.
+type problem struct {
+	line  int // line the field starts on
+	field int // 0-based field index in its record
+	msg   string
+}
+
+// validateQuoting fails on values whose quoting does not follow RFC 4180
+// (with ";" as the separator): quotes in unquoted values, undoubled quotes
+// inside quoted values, whitespace outside the quotes, and quoted values
+// that are never closed. The
+// lenient parser the other checks use accepts these silently, but Lokalise
+// and spreadsheets read them differently.
+func validateQuoting(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for quoting"}
+	}
+
+	data, base := a.Data, int64(0)
+	if bytes.HasPrefix(data, utf8BOM) {
+		data, base = data[len(utf8BOM):], int64(len(utf8BOM))
+	}
+	header, problems := scan(string(data))
+	starts := csvutil.LineStarts(data)
+
+	var bad []string
+	var fds []findings.Finding
+	for _, p := range problems {
+		col := ""
+		if p.field < len(header) {
+			col = strings.TrimSpace(header[p.field])
+		}
+		where := fmt.Sprintf("row %d", p.line)
+		if col != "" {
+			where += " " + col
+		}
+		bad = append(bad, where)
+		fds = append(fds, findings.At(p.line, col, base+starts[p.line-1], p.msg))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "all values are correctly quoted"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "broken quoting: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// scan walks s as semicolon-separated records and returns the first
+// non-blank record (the header) and every field with broken quoting. Broken
+// fields are read the way the lenient parser reads them, so one mistake
+// does not cascade into the rest of the file.
+func scan(s string) (header []string, problems []problem) {
+	line := 1
+	var rec []string
+	endRecord := func() {
+		if header == nil && csvutil.AnyNonEmpty(rec) {
+			header = rec
+		}
+		rec = nil
+	}
+
+	i := 0
+	for i < len(s) {
+		startLine, field := line, len(rec)
+		var val strings.Builder
+		if j := skipBlanks(s, i); j < len(s) && s[j] == '"' {
+			padded := j > i
+			i = j + 1
+			stray, closed := false, false
+			for i < len(s) {
+				c := s[i]
+				if c == '"' {
+					if i+1 < len(s) && s[i+1] == '"' {
+						val.WriteByte('"')
+						i += 2
+						continue
+					}
+					if k := skipBlanks(s, i+1); k == len(s) || s[k] == ';' || s[k] == '\n' || crlf(s, k) {
+						padded = padded || k > i+1
+						i = k
+						closed = true
+						break
+					}
+					stray = true
+				}
+				if c == '\n' {
+					line++
+				}
+				val.WriteByte(c)
+				i++
+			}
+			switch {
+			case !closed:
+				problems = append(problems, problem{startLine, field,
+					"quoted value is never closed and runs to the end of the file; add the closing quote"})
+			case stray:
+				problems = append(problems, problem{startLine, field,
+					"quote inside a quoted value is not doubled; write it as " + quote(val.String())})
+			case padded:
+				problems = append(problems, problem{startLine, field,
+					"whitespace around a quoted value; write it as " + quote(val.String())})
+			}
+		} else {
+			for i < len(s) && s[i] != ';' && s[i] != '\n' && !crlf(s, i) {
+				val.WriteByte(s[i])
+				i++
+			}
+			if v := val.String(); strings.Contains(v, `"`) {
+				problems = append(problems, problem{startLine, field,
+					"quote in an unquoted value; write it as " + quote(v)})
+			}
+		}
+		rec = append(rec, val.String())
+
+		// Separator or end of record.
+		switch {
+		case i >= len(s):
+		case s[i] == ';':
+			i++
+			if i == len(s) {
+				rec = append(rec, "")
+			}
+		default: // "\n" or "\r\n"
+			if s[i] == '\r' {
+				i++
+			}
+			i++
+			line++
+			endRecord()
+		}
+	}
+	endRecord()
+	return header, problems
+}
+
+// skipBlanks returns the index of the first byte at or after i that is not
+// a space or tab.
+func skipBlanks(s string, i int) int {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+		i++
+	}
+	return i
+}
+
+// crlf reports whether a "\r\n" line break starts at s[i]. A lone "\r" is
+// data, as it is for encoding/csv.
+func crlf(s string, i int) bool {
+	return i+1 < len(s) && s[i] == '\r' && s[i+1] == '\n'
+}
+
+// quote renders v as a correctly quoted CSV value.
+func quote(v string) string {
+	return `"` + strings.ReplaceAll(v, `"`, `""`) + `"`
+}
@@ -0,0 +1,62 @@
+package quoting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want []problem
+	}{
+		{"valid", "term;description\n\"a;b\";\"say \"\"hi\"\"\"\r\n\"multi\nline\";x\n", nil},
+		{"bare quote", "term;description\nsay \"hi\";x\n", []problem{
+			{2, 0, `quote in an unquoted value; write it as "say ""hi"""`},
+		}},
+		{"undoubled quote", "term;description\nlogin;\"the \"main\" button\"\n", []problem{
+			{2, 1, `quote inside a quoted value is not doubled; write it as "the ""main"" button"`},
+		}},
+		{"space around quotes", "term;description\nlogin; \"x;y\" \n\"ok\"\t;x\n", []problem{
+			{2, 1, `whitespace around a quoted value; write it as "x;y"`},
+			{3, 0, `whitespace around a quoted value; write it as "ok"`},
+		}},
+		{"unclosed", "term;description\nlogin;ok\n\"cart;x\nfoo;bar\n", []problem{
+			{3, 0, "quoted value is never closed and runs to the end of the file; add the closing quote"},
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, got := scan(tc.in)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %+v\nwant %+v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("problem %d = %+v, want %+v", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRunQuoting(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("\ufeffterm;description\nlogin;ok\nsay \"hi\";x\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runQuoting(ctx, a, checks.RunOptions{})
+	want := "broken quoting: row 3 term (total 1)"
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+	if fds := col.Findings(); len(fds) != 1 || fds[0].Column != "term" || fds[0].Offset != 29 {
+		t.Fatalf("findings = %+v", fds)
+	}
+}
@@ -0,0 +1,110 @@
+package no_letter_terms
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-no-letter-terms"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runNoLetterTerms,
+		checks.WithPriority(43),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-NO-LETTERS", Remediation: checkmeta.Remediation{
+		Hint: "Delete rows whose term is only numbers or punctuation; they are usually left over from a spreadsheet export.",
+	}})
+}
+
+func runNoLetterTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateNoLetterTerms,
+		Fix:      nil,
+		PassMsg:  "every term contains letters",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateNoLetterTerms warns about terms without a single letter, such as
+// "42", "1.", "---" or "#". They are nearly always totals, row numbers or
+// separators left over from a spreadsheet. Empty terms are reported by
+// no-empty-term-values.
+func validateNoLetterTerms(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for terms without letters"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping terms without letters)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping terms without letters)"}
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		kind := classify(term)
+		if kind == "" {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("%q (row %d)", term, row.Line))
+		fds = append(fds, findings.At(row.Line, termName, row.Offset, "term is "+kind))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "every term contains letters"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms without letters: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// classify returns "numeric-only" for a term of digits (with separators
+// such as "1,000.5" or "-3"), "punctuation-only" for any other term without
+// letters, and "" for terms with letters and empty terms.
+func classify(term string) string {
+	if term == "" {
+		return ""
+	}
+	digits := false
+	for _, r := range term {
+		switch {
+		case unicode.IsLetter(r):
+			return ""
+		case unicode.IsDigit(r):
+			digits = true
+		}
+	}
+	if digits {
+		return "numeric-only"
+	}
+	return "punctuation-only"
+}
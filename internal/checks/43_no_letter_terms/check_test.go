@@ -0,0 +1,40 @@
+package no_letter_terms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestClassify(t *testing.T) {
+	cases := map[string]string{
+		"":        "",
+		"login":   "",
+		"3D":      "",
+		"ĺ":       "",
+		"42":      "numeric-only",
+		"1,000.5": "numeric-only",
+		"-3":      "numeric-only",
+		"---":     "punctuation-only",
+		"#":       "punctuation-only",
+		"…":       "punctuation-only",
+	}
+	for in, want := range cases {
+		if got := classify(in); got != want {
+			t.Errorf("classify(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRunNoLetterTerms(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description\nlogin;x\n42;total\n---;\n"),
+		Path: "g.csv",
+	}
+	out := runNoLetterTerms(context.Background(), a, checks.RunOptions{})
+	want := `terms without letters: "42" (row 3), "---" (row 4) (total 2)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
@@ -0,0 +1,112 @@
+package edge_punctuation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-edge-punctuation"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runEdgePunctuation,
+		checks.WithPriority(44),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-EDGE-PUNCT", Remediation: checkmeta.Remediation{
+		Hint: "Remove punctuation around the term, or list legitimate terms such as \"C#\" under allow.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runEdgePunctuation(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateEdgePunctuation,
+		Fix:      nil,
+		PassMsg:  "no terms start or end with punctuation",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateEdgePunctuation warns about terms starting or ending with
+// punctuation ("login,", "(beta)", ":save"), except those listed under
+// allow. Terms without any letter are left to warn-no-letter-terms.
+func validateEdgePunctuation(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for edge punctuation"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping edge punctuation)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping edge punctuation)"}
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+	allow := map[string]struct{}{}
+	for _, t := range config.Get().Checks.EdgePunct.Allow {
+		allow[strings.TrimSpace(t)] = struct{}{}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		if _, ok := allow[term]; ok || !strings.ContainsFunc(term, unicode.IsLetter) {
+			continue
+		}
+		where := edges(term)
+		if where == "" {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("%q (row %d)", term, row.Line))
+		fds = append(fds, findings.At(row.Line, termName, row.Offset, where))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no terms start or end with punctuation"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms starting or ending with punctuation: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// edges describes punctuation at the start and end of term, e.g.
+// `starts with "("; ends with ")"`, or returns "".
+func edges(term string) string {
+	first, _ := utf8.DecodeRuneInString(term)
+	last, _ := utf8.DecodeLastRuneInString(term)
+	var parts []string
+	if unicode.IsPunct(first) {
+		parts = append(parts, fmt.Sprintf("starts with %q", first))
+	}
+	if unicode.IsPunct(last) {
+		parts = append(parts, fmt.Sprintf("ends with %q", last))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,49 @@
+package edge_punctuation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestEdges(t *testing.T) {
+	cases := map[string]string{
+		"login":   "",
+		"log-in":  "",
+		"C++":     "",
+		"login,":  `ends with ','`,
+		":save":   `starts with ':'`,
+		"(beta)":  `starts with '('; ends with ')'`,
+		"e.g.":    `ends with '.'`,
+		"«Hallo»": `starts with '«'; ends with '»'`,
+	}
+	for in, want := range cases {
+		if got := edges(in); got != want {
+			t.Errorf("edges(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRunEdgePunctuation(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description\nC#;x\n.NET;x\nlogin.;x\n...;x\n"),
+		Path: "g.csv",
+	}
+
+	out := runEdgePunctuation(context.Background(), a, checks.RunOptions{})
+	want := `terms starting or ending with punctuation: "C#" (row 2), ".NET" (row 3), "login." (row 4) (total 3)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{EdgePunct: config.EdgePunct{Allow: []string{"C#", ".NET"}}}})
+	t.Cleanup(func() { config.Set(nil) })
+	out = runEdgePunctuation(context.Background(), a, checks.RunOptions{})
+	want = `terms starting or ending with punctuation: "login." (row 4) (total 1)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
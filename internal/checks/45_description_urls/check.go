@@ -0,0 +1,184 @@
+package description_urls
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-description-urls"
+
+// urlRe finds URL candidates. It is deliberately loose about the slashes
+// after the scheme so that "http:/example.com" is found and reported.
+var urlRe = regexp.MustCompile(`(?i)\bhttps?:/*[^\s<>"'` + "`" + `]*`)
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runDescriptionURLs,
+		checks.WithPriority(45),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-URL", Remediation: checkmeta.Remediation{
+		Hint: "Fix or update the reported links; style guides and docs pages move.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runDescriptionURLs(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateDescriptionURLs,
+		Fix:      nil,
+		PassMsg:  "all URLs in descriptions are valid",
+		FailAs:   checks.Warn,
+	})
+}
+
+// occurrence is a URL found in a description cell.
+type occurrence struct {
+	url    string
+	row    csvutil.Row
+	column string
+}
+
+// validateDescriptionURLs warns about malformed URLs in description cells
+// (the main and per-language ones) and, with check-links, about URLs that
+// cannot be fetched.
+func validateDescriptionURLs(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for URLs"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping URLs)"}
+	}
+	var cols []int
+	for i, h := range tbl.Header {
+		if n := csvutil.NormalizeHeader(h); n == "description" || strings.HasSuffix(n, "_description") {
+			cols = append(cols, i)
+		}
+	}
+
+	var found []occurrence
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			for _, u := range extractURLs(row.Get(c)) {
+				found = append(found, occurrence{u, row, strings.TrimSpace(tbl.Header[c])})
+			}
+		}
+	}
+	if len(found) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no URLs in descriptions"}
+	}
+
+	problems := make([]string, len(found))
+	var toFetch []string
+	for i, o := range found {
+		problems[i] = malformed(o.url)
+		if problems[i] == "" {
+			toFetch = append(toFetch, o.url)
+		}
+	}
+	if config.Get().Checks.URLs.CheckLinks {
+		broken := checkLinks(ctx, toFetch)
+		if err := ctx.Err(); err != nil {
+			return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+		}
+		for i, o := range found {
+			if problems[i] == "" {
+				problems[i] = broken[o.url]
+			}
+		}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for i, o := range found {
+		if problems[i] == "" {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("row %d %s", o.row.Line, o.column))
+		fds = append(fds, findings.At(o.row.Line, o.column, o.row.Offset, fmt.Sprintf("%s: %s", o.url, problems[i])))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: fmt.Sprintf("all %d URL(s) in descriptions are valid", len(found))}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "invalid URLs in descriptions: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// extractURLs returns the URLs in s without trailing sentence punctuation.
+// A closing bracket is kept when the URL has a matching opening one, as in
+// Wikipedia links.
+func extractURLs(s string) []string {
+	var out []string
+	for _, u := range urlRe.FindAllString(s, -1) {
+		for len(u) > 0 {
+			last := u[len(u)-1]
+			if strings.IndexByte(".,;:!?", last) >= 0 ||
+				(last == ')' && strings.Count(u, "(") < strings.Count(u, ")")) ||
+				(last == ']' && strings.Count(u, "[") < strings.Count(u, "]")) {
+				u = u[:len(u)-1]
+				continue
+			}
+			break
+		}
+		out = append(out, u)
+	}
+	return out
+}
+
+// unwrapURLError drops the "parse <url>:" or "Get <url>:" prefix the url
+// and http packages add; findings already show the URL.
+func unwrapURLError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return ue.Err
+	}
+	return err
+}
+
+// malformed describes what is wrong with the URL u, or returns "".
+func malformed(u string) string {
+	scheme, rest, _ := strings.Cut(u, ":")
+	if !strings.HasPrefix(rest, "//") || strings.HasPrefix(rest, "///") {
+		return "expected two slashes after " + strings.ToLower(scheme) + ":"
+	}
+	p, err := url.Parse(u)
+	if err != nil {
+		return "cannot be parsed: " + unwrapURLError(err).Error()
+	}
+	host := p.Hostname()
+	switch {
+	case host == "":
+		return "has no host"
+	case strings.HasPrefix(host, ".") || strings.HasSuffix(host, ".") || strings.Contains(host, ".."):
+		return fmt.Sprintf("host %q is malformed", host)
+	}
+	return ""
+}
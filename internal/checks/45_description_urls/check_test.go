@@ -0,0 +1,80 @@
+package description_urls
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestExtractURLs(t *testing.T) {
+	got := extractURLs("See https://example.com/guide. Also (http://x.io/a_(b)) and https://w.org/wiki/Foo_(bar), done")
+	want := []string{"https://example.com/guide", "http://x.io/a_(b)", "https://w.org/wiki/Foo_(bar)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	cases := map[string]string{
+		"https://example.com/a?b=c#d": "",
+		"http://localhost:8080/x":     "",
+		"http://intranet/wiki":        "",
+		"http:/example.com":           "expected two slashes after http:",
+		"https:///example.com":        "expected two slashes after https:",
+		"https://":                    "has no host",
+		"https://example..com":        `host "example..com" is malformed`,
+		"http://example.com:99999x":   `cannot be parsed: invalid port ":99999x" after host`,
+	}
+	for in, want := range cases {
+		if got := malformed(in); got != want {
+			t.Errorf("malformed(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRunDescriptionURLs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	data := "term;description;de_description\n" +
+		"login;see " + srv.URL + "/ok and " + srv.URL + "/get-only;\n" +
+		"cart;http:/broken.example;moved: " + srv.URL + "/gone\n"
+	a := checks.Artifact{Data: []byte(data), Path: "g.csv"}
+
+	out := runDescriptionURLs(context.Background(), a, checks.RunOptions{})
+	want := "invalid URLs in descriptions: row 3 description (total 1)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("offline: got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{URLs: config.URLs{CheckLinks: true}}})
+	t.Cleanup(func() { config.Set(nil) })
+	ctx, col := findings.WithCollector(context.Background())
+	out = runDescriptionURLs(ctx, a, checks.RunOptions{})
+	want = "invalid URLs in descriptions: row 3 description, row 3 de_description (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("check-links: got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 2 || !strings.HasSuffix(fds[1].Message, "/gone: returned 404 Not Found") {
+		t.Fatalf("findings = %+v", fds)
+	}
+}
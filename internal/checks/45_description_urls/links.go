@@ -0,0 +1,90 @@
+package description_urls
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// linkWorkers bounds concurrent requests; linkTimeout bounds each one.
+const (
+	linkWorkers = 8
+	linkTimeout = 10 * time.Second
+)
+
+// httpClient is replaced in tests.
+var httpClient = &http.Client{Timeout: linkTimeout}
+
+// linkCache keeps results for the whole run, so a style guide linked from
+// every file is requested once.
+var linkCache sync.Map // url -> string ("" when reachable)
+
+// checkLinks requests each URL and returns a problem description for every
+// one that fails.
+func checkLinks(ctx context.Context, urls []string) map[string]string {
+	todo := make(chan string)
+	var wg sync.WaitGroup
+	for range min(linkWorkers, len(urls)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range todo {
+				if _, ok := linkCache.Load(u); ok {
+					continue
+				}
+				// A cancelled run says nothing about the link; do not cache it.
+				if problem := fetch(ctx, u); ctx.Err() == nil {
+					linkCache.Store(u, problem)
+				}
+			}
+		}()
+	}
+	seen := map[string]bool{}
+	for _, u := range urls {
+		if !seen[u] {
+			seen[u] = true
+			todo <- u
+		}
+	}
+	close(todo)
+	wg.Wait()
+
+	out := make(map[string]string, len(seen))
+	for u := range seen {
+		if v, ok := linkCache.Load(u); ok && v.(string) != "" {
+			out[u] = v.(string)
+		}
+	}
+	return out
+}
+
+// fetch tries HEAD and falls back to GET for servers that do not allow it.
+func fetch(ctx context.Context, u string) string {
+	status, err := request(ctx, http.MethodHead, u)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented || status == http.StatusForbidden) {
+		status, err = request(ctx, http.MethodGet, u)
+	}
+	switch {
+	case err != nil:
+		return "unreachable: " + unwrapURLError(err).Error()
+	case status >= 400:
+		return fmt.Sprintf("returned %d %s", status, http.StatusText(status))
+	}
+	return ""
+}
+
+func request(ctx context.Context, method, u string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", "lokalise-glossary-guard")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
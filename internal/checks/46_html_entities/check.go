@@ -0,0 +1,117 @@
+package html_entities
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-html-entities"
+
+// entityRe matches named, decimal and hex character references.
+var entityRe = regexp.MustCompile(`&(?:[A-Za-z][A-Za-z0-9]{1,31}|#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6});`)
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runHTMLEntities,
+		checks.WithPriority(46),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-HTML-ENTITY", Remediation: checkmeta.Remediation{
+		Hint: "Replace HTML entities such as &amp; with the characters they stand for; the export was not decoded.",
+	}})
+}
+
+func runHTMLEntities(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateHTMLEntities,
+		Fix:      nil,
+		PassMsg:  "no HTML entities in cells",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateHTMLEntities warns about cells containing HTML character
+// references (&amp;, &#39;, &nbsp;), a sign that the glossary was exported
+// from HTML without decoding. Only references that HTML actually defines
+// are reported, so "R&D;" is left alone.
+func validateHTMLEntities(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for HTML entities"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping HTML entities)"}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for c, v := range row.Cells {
+			if c >= len(tbl.Header) {
+				continue
+			}
+			found := findEntities(v)
+			if len(found) == 0 {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, "HTML entities: "+strings.Join(found, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no HTML entities in cells"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "cells with HTML entities: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// findEntities lists the distinct character references in s with what
+// they decode to, e.g. `&amp; (&)`. Invisible results are shown escaped.
+func findEntities(s string) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, m := range entityRe.FindAllString(s, -1) {
+		dec := html.UnescapeString(m)
+		if dec == m || seen[m] {
+			continue
+		}
+		seen[m] = true
+		shown := dec
+		if strings.TrimSpace(dec) == "" || !strings.ContainsFunc(dec, isVisible) {
+			shown = fmt.Sprintf("%+q", dec)
+			shown = shown[1 : len(shown)-1]
+		}
+		out = append(out, fmt.Sprintf("%s (%s)", m, shown))
+	}
+	return out
+}
+
+func isVisible(r rune) bool {
+	return r > ' ' && r != 0x7f && r != 0xa0
+}
@@ -0,0 +1,39 @@
+package html_entities
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFindEntities(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"Terms & Conditions", nil},
+		{"R&D; team", nil},
+		{"Terms &amp; Conditions &amp; more", []string{"&amp; (&)"}},
+		{"don&#39;t", []string{"&#39; (')"}},
+		{"a&nbsp;b &#x2014;", []string{`&nbsp; (\u00a0)`, "&#x2014; (—)"}},
+	}
+	for _, tc := range cases {
+		if got := findEntities(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findEntities(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRunHTMLEntities(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;de\n\"Terms &amp; Conditions\";ok;AGB\nlogin;\"it&#39;s\";anmelden\n"),
+		Path: "g.csv",
+	}
+	out := runHTMLEntities(context.Background(), a, checks.RunOptions{})
+	want := "cells with HTML entities: row 2 term, row 3 description (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
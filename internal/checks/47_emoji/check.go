@@ -0,0 +1,133 @@
+package emoji
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "emoji-in-terms"
+
+// pictographs covers the emoji and pictograph blocks. Symbols that are
+// common in plain text (©, ™, arrows, ⌘) are outside it.
+var pictographs = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1}, // watch, hourglass
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1}, // media controls, alarm clock
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1}, // miscellaneous symbols, dingbats
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1}, // large squares
+		{Lo: 0x2b50, Hi: 0x2b55, Stride: 5}, // star, circle
+		{Lo: 0xfe0f, Hi: 0xfe0f, Stride: 1}, // emoji presentation selector
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1faff, Stride: 1}, // pictographs, emoticons, flags, ...
+	},
+}
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runEmoji,
+		checks.WithPriority(47),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-EMOJI", Remediation: checkmeta.Remediation{
+		Hint: "Remove emoji and pictographs from terms; CAT tools rarely match them.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+// runEmoji reports terms containing emoji as warnings, or as failures in
+// strict mode.
+func runEmoji(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	failAs := checks.Warn
+	if config.Get().Checks.Emoji.Strict {
+		failAs = checks.Fail
+	}
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateEmoji,
+		Fix:      nil,
+		PassMsg:  "no emoji in terms",
+		FailAs:   failAs,
+	})
+}
+
+// validateEmoji reports terms containing emoji or pictographs, which break
+// term matching in most CAT tools.
+func validateEmoji(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for emoji"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping emoji)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping emoji)"}
+	}
+	termName := strings.TrimSpace(tbl.Header[termCol])
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		term := strings.TrimSpace(row.Get(termCol))
+		found := findEmoji(term)
+		if len(found) == 0 {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("%q (row %d)", term, row.Line))
+		fds = append(fds, findings.At(row.Line, termName, row.Offset, "emoji: "+strings.Join(found, ", ")))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no emoji in terms"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "terms with emoji: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// findEmoji lists the distinct pictographs in s with their code points.
+// Presentation selectors are only reported on their own, after a character
+// that is not a pictograph ("❤" is found, "❤️" is reported once).
+func findEmoji(s string) []string {
+	var out []string
+	seen := map[rune]bool{}
+	prev := rune(0)
+	for _, r := range s {
+		isPic := unicode.Is(pictographs, r)
+		if isPic && !seen[r] && !(r == 0xfe0f && unicode.Is(pictographs, prev)) {
+			seen[r] = true
+			if r == 0xfe0f {
+				out = append(out, fmt.Sprintf("emoji presentation selector (U+%04X) after %q", r, prev))
+			} else {
+				out = append(out, fmt.Sprintf("%c (U+%04X)", r, r))
+			}
+		}
+		prev = r
+	}
+	return out
+}
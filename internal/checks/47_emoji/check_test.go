@@ -0,0 +1,49 @@
+package emoji
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestFindEmoji(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"Lokalise™ ©2024 → ⌘K", nil},
+		{"Save \U0001F4BE", []string{"\U0001F4BE (U+1F4BE)"}},
+		{"love ❤️ ❤", []string{"❤ (U+2764)"}},
+		{"ok ✅ \U0001F44D\U0001F3FD", []string{"✅ (U+2705)", "\U0001F44D (U+1F44D)", "\U0001F3FD (U+1F3FD)"}},
+		{"1️", []string{"emoji presentation selector (U+FE0F) after '1'"}},
+	}
+	for _, tc := range cases {
+		if got := findEmoji(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findEmoji(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRunEmoji(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description\nSave \U0001F4BE;x\nlogin;\U0001F511 key\n"),
+		Path: "g.csv",
+	}
+	want := "terms with emoji: \"Save \U0001F4BE\" (row 2) (total 1)"
+
+	out := runEmoji(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{Emoji: config.Emoji{Strict: true}}})
+	t.Cleanup(func() { config.Set(nil) })
+	out = runEmoji(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("strict: got %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+}
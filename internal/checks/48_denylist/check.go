@@ -0,0 +1,103 @@
+package denylist
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "no-denylisted-terms"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runDenylist,
+		checks.WithPriority(48),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-DENYLIST", Remediation: checkmeta.Remediation{
+		Hint: "Replace denylisted words (outdated brand or product names, offensive terms) in terms and translations.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runDenylist(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateDenylist,
+		Fix:      nil,
+		PassMsg:  "no denylisted terms",
+		FailAs:   checks.Fail,
+	})
+}
+
+// validateDenylist fails rows whose term or translations match a pattern
+// of the configured denylist file.
+func validateDenylist(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	path := config.Get().Checks.Denylist.File
+	if path == "" {
+		return checks.ValidationResult{OK: true, Msg: "no denylist configured"}
+	}
+	patterns, err := load(path)
+	if err != nil {
+		return checks.ValidationResult{OK: false, Msg: err.Error(), Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for denylisted terms"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping denylisted terms)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping denylisted terms)"}
+	}
+	cols := append([]int{termCol}, tbl.LangCols()...)
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		var hits []string
+		for _, c := range cols {
+			v := row.Get(c)
+			for _, p := range patterns {
+				if !p.re.MatchString(v) {
+					continue
+				}
+				col := strings.TrimSpace(tbl.Header[c])
+				hits = append(hits, fmt.Sprintf("%s matches %q", col, p.text))
+				fds = append(fds, findings.At(row.Line, col, row.Offset, fmt.Sprintf("%q matches denylist entry %q", strings.TrimSpace(v), p.text)))
+			}
+		}
+		if len(hits) > 0 {
+			bad = append(bad, fmt.Sprintf("row %d (%s)", row.Line, strings.Join(hits, ", ")))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no denylisted terms"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "denylisted terms: " + csvutil.JoinLimited(bad, "; ", 10),
+	}
+}
@@ -0,0 +1,82 @@
+package denylist
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func writeDenylist(t *testing.T, content string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config.Set(&config.Config{Checks: config.Checks{Denylist: config.Denylist{File: path}}})
+	t.Cleanup(func() { config.Set(nil) })
+}
+
+func TestParse(t *testing.T) {
+	ps, err := parse([]byte("# old names\nLokalize\n\nsign  up\n/^beta-/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"lokalize API", []string{"Lokalize"}},
+		{"Lokalizer", nil},
+		{"Sign Up now", []string{"sign  up"}},
+		{"signup", nil},
+		{"beta-flag", []string{"/^beta-/"}},
+		{"Beta-flag", nil},
+		{"über Lokalize.", []string{"Lokalize"}},
+	}
+	for _, tc := range cases {
+		var got []string
+		for _, p := range ps {
+			if p.re.MatchString(tc.in) {
+				got = append(got, p.text)
+			}
+		}
+		if len(got) != len(tc.want) || (len(got) > 0 && got[0] != tc.want[0]) {
+			t.Errorf("%q matched %q, want %q", tc.in, got, tc.want)
+		}
+	}
+
+	if _, err := parse([]byte("ok\n/(/\n")); err == nil {
+		t.Error("expected an error for an invalid regex")
+	}
+}
+
+func TestRunDenylist(t *testing.T) {
+	writeDenylist(t, "Lokalize\n/(?i)master/\n")
+	a := checks.Artifact{
+		Data: []byte("term;description;de\nLokalize CLI;Lokalize;Lokalize CLI\nmaster branch;x;Hauptzweig\nok;x;ok\n"),
+		Path: "g.csv",
+	}
+	out := runDenylist(context.Background(), a, checks.RunOptions{})
+	want := `denylisted terms: row 2 (term matches "Lokalize", de matches "Lokalize"); row 3 (term matches "/(?i)master/") (total 2)`
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestRunDenylistDisabledAndBroken(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term;description\nLokalize;x\n"), Path: "g.csv"}
+	if out := runDenylist(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Pass {
+		t.Fatalf("unconfigured: got %s %q", out.Result.Status, out.Result.Message)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{Denylist: config.Denylist{File: filepath.Join(t.TempDir(), "missing.txt")}}})
+	t.Cleanup(func() { config.Set(nil) })
+	if out := runDenylist(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Error {
+		t.Fatalf("missing file: got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
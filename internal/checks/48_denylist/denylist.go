@@ -0,0 +1,69 @@
+package denylist
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// pattern is one denylist entry. text is the line as written, for messages.
+type pattern struct {
+	text string
+	re   *regexp.Regexp
+}
+
+type loaded struct {
+	patterns []pattern
+	err      error
+}
+
+// cache keeps each denylist parsed once per run; every glossary file is
+// checked against the same list.
+var cache sync.Map // path -> loaded
+
+func load(path string) ([]pattern, error) {
+	if v, ok := cache.Load(path); ok {
+		l := v.(loaded)
+		return l.patterns, l.err
+	}
+	raw, err := os.ReadFile(path)
+	var ps []pattern
+	if err == nil {
+		ps, err = parse(raw)
+	}
+	if err != nil {
+		err = fmt.Errorf("denylist %s: %w", path, err)
+	}
+	cache.Store(path, loaded{ps, err})
+	return ps, err
+}
+
+// parse reads one pattern per line. Blank lines and lines starting with #
+// are skipped. /.../ is a regular expression, matched as written; anything
+// else is plain text, matched as a whole word or phrase regardless of case.
+func parse(raw []byte) ([]pattern, error) {
+	var ps []pattern
+	sc := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(raw, []byte("\ufeff"))))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var expr string
+		if len(line) > 2 && strings.HasPrefix(line, "/") && strings.HasSuffix(line, "/") {
+			expr = line[1 : len(line)-1]
+		} else {
+			expr = `(?i)(?:^|[^\p{L}\p{N}_])` + strings.Join(strings.Fields(regexp.QuoteMeta(line)), `\s+`) + `(?:$|[^\p{L}\p{N}_])`
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		ps = append(ps, pattern{text: line, re: re})
+	}
+	return ps, sc.Err()
+}
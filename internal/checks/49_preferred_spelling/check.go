@@ -0,0 +1,112 @@
+package preferred_spelling
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-preferred-spelling"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runPreferredSpelling,
+		checks.WithPriority(49),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-SPELLING", Remediation: checkmeta.Remediation{
+		Hint: "Use the approved spelling suggested in the message.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runPreferredSpelling(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validatePreferredSpelling,
+		Fix:      nil,
+		PassMsg:  "no deprecated spellings",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validatePreferredSpelling warns about terms and descriptions using a
+// deprecated spelling from the configured mapping.
+func validatePreferredSpelling(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	cfg := config.Get().Checks.Spelling
+	mapping := map[string]string{}
+	if cfg.File != "" {
+		m, err := loadFile(cfg.File)
+		if err != nil {
+			return checks.ValidationResult{OK: false, Msg: err.Error(), Err: err}
+		}
+		maps.Copy(mapping, m)
+	}
+	maps.Copy(mapping, cfg.Replace)
+	spellings := compile(mapping)
+	if len(spellings) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no preferred spellings configured"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for preferred spellings"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping preferred spellings)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping preferred spellings)"}
+	}
+	cols := []int{termCol}
+	for i, h := range tbl.Header {
+		if n := csvutil.NormalizeHeader(h); n == "description" || strings.HasSuffix(n, "_description") {
+			cols = append(cols, i)
+		}
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			v := row.Get(c)
+			for _, sp := range spellings {
+				used, suggest := sp.find(v)
+				for i := range used {
+					col := strings.TrimSpace(tbl.Header[c])
+					msg := fmt.Sprintf("%q → %q", used[i], suggest[i])
+					bad = append(bad, fmt.Sprintf("%s (row %d, %s)", msg, row.Line, col))
+					fds = append(fds, findings.At(row.Line, col, row.Offset, "use "+msg))
+				}
+			}
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no deprecated spellings"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "deprecated spellings: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
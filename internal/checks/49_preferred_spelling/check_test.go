@@ -0,0 +1,72 @@
+package preferred_spelling
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestFind(t *testing.T) {
+	sps := compile(map[string]string{"e-mail": "email", "Javascript": "JavaScript", "log  in": "sign in"})
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"Send e-mail", []string{"email"}},
+		{"E-mail address", []string{"Email"}},
+		{"E-mails", nil},
+		{"JavaScript SDK", nil},
+		{"javascript SDK", []string{"JavaScript"}},
+		{"Log in, then log in again", []string{"Sign in", "sign in"}},
+	}
+	for _, tc := range cases {
+		var got []string
+		for _, sp := range sps {
+			_, s := sp.find(tc.in)
+			got = append(got, s...)
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%q: got %q, want %q", tc.in, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%q: got %q, want %q", tc.in, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestRunPreferredSpelling(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spellings.yaml")
+	if err := os.WriteFile(path, []byte("e-mail: email\nweb site: website\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config.Set(&config.Config{Checks: config.Checks{Spelling: config.Spelling{
+		File:    path,
+		Replace: map[string]string{"web site": "site"},
+	}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;de;de_description\nE-mail;Our web site;E-Mail;Per e-mail\nemail;ok;x;y\n"),
+		Path: "g.csv",
+	}
+	out := runPreferredSpelling(context.Background(), a, checks.RunOptions{})
+	want := `deprecated spellings: "E-mail" → "Email" (row 2, term), "web site" → "site" (row 2, description), "e-mail" → "email" (row 2, de_description) (total 3)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestRunPreferredSpellingUnconfigured(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term;description\ne-mail;x\n"), Path: "g.csv"}
+	if out := runPreferredSpelling(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Pass {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,92 @@
+package preferred_spelling
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// spelling is a deprecated spelling and its approved replacement.
+type spelling struct {
+	deprecated, approved string
+	re                   *regexp.Regexp
+}
+
+type loaded struct {
+	m   map[string]string
+	err error
+}
+
+// fileCache keeps each mapping file parsed once per run.
+var fileCache sync.Map // path -> loaded
+
+func loadFile(path string) (map[string]string, error) {
+	if v, ok := fileCache.Load(path); ok {
+		l := v.(loaded)
+		return l.m, l.err
+	}
+	var m map[string]string
+	raw, err := os.ReadFile(path)
+	if err == nil {
+		err = yaml.Unmarshal(raw, &m)
+	}
+	if err != nil {
+		err = fmt.Errorf("spellings %s: %w", path, err)
+	}
+	fileCache.Store(path, loaded{m, err})
+	return m, err
+}
+
+// compile builds matchers for m, sorted by deprecated spelling. A
+// deprecated spelling matches as a whole word or phrase, ignoring case and
+// the amount of whitespace between words.
+func compile(m map[string]string) []spelling {
+	var out []spelling
+	for dep, ok := range m {
+		dep = strings.TrimSpace(dep)
+		if dep == "" {
+			continue
+		}
+		expr := strings.Join(strings.Fields(regexp.QuoteMeta(dep)), `\s+`)
+		out = append(out, spelling{
+			deprecated: dep,
+			approved:   strings.TrimSpace(ok),
+			re:         regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])(` + expr + `)(?:$|[^\p{L}\p{N}_])`),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].deprecated < out[j].deprecated })
+	return out
+}
+
+// find returns the deprecated forms used in s with their suggestions.
+// Matches already spelled as approved are skipped, so a mapping that only
+// fixes case ("Javascript": "JavaScript") does not report correct text.
+func (sp spelling) find(s string) (used, suggest []string) {
+	for _, m := range sp.re.FindAllStringSubmatch(s, -1) {
+		if m[1] == sp.approved {
+			continue
+		}
+		used = append(used, m[1])
+		suggest = append(suggest, matchCase(m[1], sp.deprecated, sp.approved))
+	}
+	return used, suggest
+}
+
+// matchCase capitalizes approved when the text starts with a capital the
+// deprecated spelling does not have ("E-mail" -> "Email").
+func matchCase(text, deprecated, approved string) string {
+	t, _ := utf8.DecodeRuneInString(text)
+	d, _ := utf8.DecodeRuneInString(deprecated)
+	a, n := utf8.DecodeRuneInString(approved)
+	if unicode.IsUpper(t) && !unicode.IsUpper(d) && unicode.IsLower(a) {
+		return string(unicode.ToUpper(a)) + approved[n:]
+	}
+	return approved
+}
@@ -0,0 +1,202 @@
+package misspellings
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/hunspell"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-misspellings"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runMisspellings,
+		checks.WithPriority(50),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-SPELLCHECK", Remediation: checkmeta.Remediation{
+		Hint: "Correct the misspelled words, or add intended ones (product names, jargon) to ignore.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runMisspellings(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateMisspellings,
+		Fix:      nil,
+		PassMsg:  "no misspellings",
+		FailAs:   checks.Warn,
+	})
+}
+
+// column is a cell column to spell-check with a dictionary.
+type column struct {
+	idx  int
+	name string
+	dict *hunspell.Dictionary
+	// terms is set for the term and language columns, as opposed to
+	// descriptions.
+	terms bool
+}
+
+// validateMisspellings spell-checks terms, translations and descriptions
+// with the Hunspell dictionary configured for their language: the "term"
+// dictionary covers the term and description columns, a language's covers
+// its column and <lang>_description. Terms marked translatable=no are
+// usually names and are not checked.
+func validateMisspellings(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	cfg := config.Get().Checks.Spellcheck
+	if len(cfg.Dictionaries) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no dictionaries configured"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for misspellings"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping misspellings)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping misspellings)"}
+	}
+
+	dicts := map[string]string{}
+	for col, base := range cfg.Dictionaries {
+		dicts[csvutil.NormalizeHeader(col)] = base
+	}
+	var cols []column
+	for i, h := range tbl.Header {
+		n := csvutil.NormalizeHeader(h)
+		lang := n
+		switch {
+		case i == termCol:
+			lang = "term"
+		case n == "description":
+			lang = "term"
+		case strings.HasSuffix(n, "_description"):
+			lang = strings.TrimSuffix(n, "_description")
+		}
+		base, ok := dicts[lang]
+		if !ok {
+			continue
+		}
+		d, err := load(base)
+		if err != nil {
+			err = fmt.Errorf("dictionary for %s: %w", lang, err)
+			return checks.ValidationResult{OK: false, Msg: err.Error(), Err: err}
+		}
+		cols = append(cols, column{idx: i, name: strings.TrimSpace(h), dict: d, terms: lang == n || i == termCol})
+	}
+	if len(cols) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no columns with a dictionary (skipping misspellings)"}
+	}
+
+	ignore := map[string]bool{}
+	for _, w := range cfg.Ignore {
+		ignore[strings.ToLower(strings.TrimSpace(w))] = true
+	}
+	untranslatable := tbl.Col("translatable")
+
+	var bad []string
+	var fds []findings.Finding
+	for ri, row := range tbl.Rows {
+		if ri%256 == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+			}
+		}
+		skipNames := untranslatable >= 0 && strings.EqualFold(strings.TrimSpace(row.Get(untranslatable)), "no")
+		for _, c := range cols {
+			if skipNames && c.terms {
+				continue
+			}
+			var wrong []string
+			seen := map[string]bool{}
+			for _, w := range misspelled(c.dict, row.Get(c.idx)) {
+				if ignore[strings.ToLower(w)] || seen[w] {
+					continue
+				}
+				seen[w] = true
+				wrong = append(wrong, w)
+			}
+			if len(wrong) == 0 {
+				continue
+			}
+			for _, w := range wrong {
+				bad = append(bad, fmt.Sprintf("%q (row %d, %s)", w, row.Line, c.name))
+			}
+			fds = append(fds, findings.At(row.Line, c.name, row.Offset, "misspelled: "+strings.Join(wrong, ", ")))
+		}
+	}
+	sort.SliceStable(fds, func(i, j int) bool { return fds[i].Row < fds[j].Row })
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no misspellings"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "misspelled words: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// misspelled returns the words of s that d rejects. Words are runs of
+// letters with inner apostrophes or hyphens; words containing digits
+// (versions, codes) are skipped. A hyphenated word is accepted when the
+// dictionary knows it whole or knows every part.
+func misspelled(d *hunspell.Dictionary, s string) []string {
+	var out []string
+	for _, w := range words(s) {
+		if d.Check(w) {
+			continue
+		}
+		if !strings.Contains(w, "-") {
+			out = append(out, w)
+			continue
+		}
+		for _, part := range strings.Split(w, "-") {
+			if part != "" && !d.Check(part) {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
+
+func words(s string) []string {
+	isWordRune := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '\'' || r == '’' || r == '-'
+	}
+	var out []string
+	for _, w := range strings.FieldsFunc(s, func(r rune) bool { return !isWordRune(r) }) {
+		w = strings.Trim(w, "'’-")
+		if w == "" || strings.IndexFunc(w, unicode.IsDigit) >= 0 {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package misspellings
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func writeDict(t *testing.T, dir, name, aff, dic string) string {
+	t.Helper()
+	base := filepath.Join(dir, name)
+	if err := os.WriteFile(base+".aff", []byte(aff), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(base+".dic", []byte(dic), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return base
+}
+
+func TestWords(t *testing.T) {
+	got := words("Don't re-use v2 -- it's 'quoted', (OK).")
+	want := []string{"Don't", "re-use", "it's", "quoted", "OK"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("words = %q, want %q", got, want)
+	}
+}
+
+func TestRunMisspellings(t *testing.T) {
+	dir := t.TempDir()
+	en := writeDict(t, dir, "en", "SFX S Y 1\nSFX S 0 s .\n", "6\nsave/S\nfile\nthe\nto\ndisk\nre\n")
+	de := writeDict(t, dir, "de", "", "2\nspeichern\nDatei\n")
+	config.Set(&config.Config{Checks: config.Checks{Spellcheck: config.Spellcheck{
+		Dictionaries: map[string]string{"term": en, "DE": de},
+		Ignore:       []string{"lokalise"},
+	}}})
+	t.Cleanup(func() { config.Set(nil) })
+
+	a := checks.Artifact{
+		Data: []byte("term;description;translatable;de;de_description\n" +
+			"save file;Saves teh file to disk;yes;speichern;Datei speicern\n" +
+			"Lokalise;the re-sue;yes;Lokalise;\n" +
+			"Acme Cloud;the file;no;Acme Cloud;\n"),
+		Path: "g.csv",
+	}
+	out := runMisspellings(context.Background(), a, checks.RunOptions{})
+	want := `misspelled words: "teh" (row 2, description), "speicern" (row 2, de_description), "sue" (row 3, description) (total 3)`
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestRunMisspellingsUnconfiguredOrBroken(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term;description\nteh;x\n"), Path: "g.csv"}
+	if out := runMisspellings(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Pass {
+		t.Fatalf("unconfigured: got %s %q", out.Result.Status, out.Result.Message)
+	}
+
+	config.Set(&config.Config{Checks: config.Checks{Spellcheck: config.Spellcheck{
+		Dictionaries: map[string]string{"term": filepath.Join(t.TempDir(), "missing")},
+	}}})
+	t.Cleanup(func() { config.Set(nil) })
+	if out := runMisspellings(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Error {
+		t.Fatalf("missing dictionary: got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
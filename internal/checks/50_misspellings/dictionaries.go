@@ -0,0 +1,24 @@
+package misspellings
+
+import (
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/hunspell"
+)
+
+type loaded struct {
+	once sync.Once
+	dict *hunspell.Dictionary
+	err  error
+}
+
+// cache keeps each dictionary loaded once per run. Large dictionaries take
+// a moment to expand, and every glossary file uses the same ones.
+var cache sync.Map // base path -> *loaded
+
+func load(base string) (*hunspell.Dictionary, error) {
+	v, _ := cache.LoadOrStore(base, &loaded{})
+	l := v.(*loaded)
+	l.once.Do(func() { l.dict, l.err = hunspell.Load(base) })
+	return l.dict, l.err
+}
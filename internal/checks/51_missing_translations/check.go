@@ -0,0 +1,133 @@
+package missing_translations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-missing-translations"
+
+// maxRowsPerLang caps the rows listed per language in the summary; the
+// findings have every row.
+const maxRowsPerLang = 5
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runMissingTranslations,
+		checks.WithPriority(51),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-MISSING-TRANSLATION", Remediation: checkmeta.Remediation{
+		Hint: "Add the missing translations listed per language, or mark terms that need none translatable=no.",
+	}})
+}
+
+func runMissingTranslations(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateMissingTranslations,
+		Fix:      nil,
+		PassMsg:  "every term is translated",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateMissingTranslations lists, for each row, the language columns
+// left empty, and sums them up per language. Rows that need no
+// translations (translatable=no or forbidden=yes) are skipped, as in
+// warn-translation-coverage.
+func validateMissingTranslations(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for missing translations"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping missing translations)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping missing translations)"}
+	}
+	langCols := tbl.LangCols()
+	if len(langCols) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no language columns (skipping missing translations)"}
+	}
+	translatableCol, forbiddenCol := tbl.Col("translatable"), tbl.Col("forbidden")
+
+	missing := make([][]int, len(langCols)) // rows per language column
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		if flag(row.Get(translatableCol)) == "no" || flag(row.Get(forbiddenCol)) == "yes" {
+			continue
+		}
+		var langs []string
+		for i, c := range langCols {
+			if strings.TrimSpace(row.Get(c)) == "" {
+				missing[i] = append(missing[i], row.Line)
+				langs = append(langs, strings.TrimSpace(tbl.Header[c]))
+			}
+		}
+		if len(langs) == 0 {
+			continue
+		}
+		msg := fmt.Sprintf("%q has no translation in %s", strings.TrimSpace(row.Get(termCol)), strings.Join(langs, ", "))
+		fds = append(fds, findings.At(row.Line, strings.TrimSpace(tbl.Header[termCol]), row.Offset, msg))
+	}
+	findings.Report(ctx, fds)
+
+	var parts []string
+	for i, rows := range missing {
+		if len(rows) == 0 {
+			continue
+		}
+		parts = append(parts, strings.TrimSpace(tbl.Header[langCols[i]])+": "+rowList(rows))
+	}
+	if len(parts) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "every term is translated"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: fmt.Sprintf("%d term(s) missing translations; ", len(fds)) + strings.Join(parts, "; "),
+	}
+}
+
+// rowList renders rows compactly: "3 rows (2, 5, 9)", eliding beyond
+// maxRowsPerLang.
+func rowList(rows []int) string {
+	shown := make([]string, 0, maxRowsPerLang)
+	for i, r := range rows {
+		if i == maxRowsPerLang {
+			shown = append(shown, "...")
+			break
+		}
+		shown = append(shown, strconv.Itoa(r))
+	}
+	noun := "rows"
+	if len(rows) == 1 {
+		noun = "row"
+	}
+	return fmt.Sprintf("%d %s (%s)", len(rows), noun, strings.Join(shown, ", "))
+}
+
+func flag(v string) string {
+	return strings.ToLower(strings.TrimSpace(v))
+}
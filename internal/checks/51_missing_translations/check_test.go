@@ -0,0 +1,45 @@
+package missing_translations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func TestRunMissingTranslations(t *testing.T) {
+	a := checks.Artifact{
+		Data: []byte("term;description;translatable;forbidden;de;fr;de_description\n" +
+			"save;d;yes;no;speichern;;\n" +
+			"open;d;yes;no;;;x\n" +
+			"Acme;d;no;no;;;\n" +
+			"crap;d;yes;yes;;;\n" +
+			"close;d;yes;no;schließen;fermer;\n"),
+		Path: "g.csv",
+	}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runMissingTranslations(ctx, a, checks.RunOptions{})
+	want := "2 term(s) missing translations; de: 1 row (3); fr: 2 rows (2, 3)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 2 || fds[1].Row != 3 || fds[1].Message != `"open" has no translation in de, fr` {
+		t.Fatalf("findings = %+v", fds)
+	}
+}
+
+func TestRowList(t *testing.T) {
+	if got, want := rowList([]int{2, 3, 4, 5, 6, 7, 8}), "7 rows (2, 3, 4, 5, 6, ...)"; got != want {
+		t.Errorf("rowList = %q, want %q", got, want)
+	}
+}
+
+func TestRunMissingTranslationsComplete(t *testing.T) {
+	a := checks.Artifact{Data: []byte("term;description;de\nsave;d;speichern\n"), Path: "g.csv"}
+	if out := runMissingTranslations(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Pass {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
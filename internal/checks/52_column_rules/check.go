@@ -0,0 +1,143 @@
+package column_rules
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "column-rules"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runColumnRules,
+		checks.WithPriority(52),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-COLUMN-RULE", Remediation: checkmeta.Remediation{
+		Hint: "Change the reported cells to satisfy the column rules of the configuration.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+// rule is a compiled config.ColumnRule.
+type rule struct {
+	column        string
+	match, reject *regexp.Regexp
+	severity      checks.Status
+	message       string
+}
+
+func compile(cfg []config.ColumnRule) []rule {
+	var out []rule
+	for _, r := range cfg {
+		cr := rule{column: strings.ToLower(strings.TrimSpace(r.Column)), severity: checks.Fail, message: r.Message}
+		if r.Severity == "warn" {
+			cr.severity = checks.Warn
+		}
+		// The config validated the expressions when it was loaded.
+		if r.MustMatch != "" {
+			cr.match = regexp.MustCompile(r.MustMatch)
+		}
+		if r.MustNotMatch != "" {
+			cr.reject = regexp.MustCompile(r.MustNotMatch)
+		}
+		out = append(out, cr)
+	}
+	return out
+}
+
+// violation returns why v breaks r, or "".
+func (r rule) violation(v string) string {
+	var why string
+	switch {
+	case r.match != nil && !r.match.MatchString(v):
+		why = "does not match " + r.match.String()
+	case r.reject != nil && r.reject.MatchString(v):
+		why = "matches " + r.reject.String()
+	default:
+		return ""
+	}
+	if r.message != "" {
+		return r.message
+	}
+	return why
+}
+
+// runColumnRules applies the configured column rules. Each rule fails or
+// warns on its own, so the status is the most severe one violated.
+func runColumnRules(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	if err := ctx.Err(); err != nil {
+		return checks.OutcomeKeep(checks.Error, checkName, "validation cancelled", a, "")
+	}
+	rules := compile(config.Get().Checks.ColumnRules)
+	if len(rules) == 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no column rules configured", a, "")
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no content to validate for column rules", a, "")
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.OutcomeKeep(checks.Pass, checkName, "no parsable header (skipping column rules)", a, "")
+	}
+
+	// cols[i] lists the header indexes rule i applies to.
+	cols := make([][]int, len(rules))
+	for i, r := range rules {
+		for c, h := range tbl.Header {
+			if ok, _ := path.Match(r.column, csvutil.NormalizeHeader(h)); ok {
+				cols[i] = append(cols[i], c)
+			}
+		}
+	}
+
+	status := checks.Pass
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for i, r := range rules {
+			for _, c := range cols[i] {
+				v := row.Get(c)
+				// Empty cells are the business of the checks for required values.
+				if strings.TrimSpace(v) == "" {
+					continue
+				}
+				why := r.violation(v)
+				if why == "" {
+					continue
+				}
+				col := strings.TrimSpace(tbl.Header[c])
+				bad = append(bad, fmt.Sprintf("%s %q (row %d)", col, v, row.Line))
+				f := findings.At(row.Line, col, row.Offset, fmt.Sprintf("%q %s", v, why))
+				f.Severity = r.severity
+				fds = append(fds, f)
+				if r.severity == checks.Fail || status == checks.Pass {
+					status = r.severity
+				}
+			}
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if status == checks.Pass {
+		return checks.OutcomeKeep(checks.Pass, checkName, fmt.Sprintf("all cells satisfy %d column rule(s)", len(rules)), a, "")
+	}
+	return checks.OutcomeKeep(status, checkName, "cells breaking column rules: "+csvutil.JoinLimited(bad, ", ", 10), a, "")
+}
@@ -0,0 +1,65 @@
+package column_rules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func setRules(t *testing.T, rules ...config.ColumnRule) {
+	t.Helper()
+	config.Set(&config.Config{Checks: config.Checks{ColumnRules: rules}})
+	t.Cleanup(func() { config.Set(nil) })
+}
+
+const data = "term;description;tags;de;de_description\n" +
+	"log in;Sign into the app;auth;anmelden;Bei der App anmelden\n" +
+	"log_in!;TODO;;;TODO\n"
+
+func TestRunColumnRules(t *testing.T) {
+	setRules(t,
+		config.ColumnRule{Column: "Term", MustMatch: `^[\p{L}\d \-']+$`},
+		config.ColumnRule{Column: "*description", MustNotMatch: `(?i)\btodo\b`, Severity: "warn", Message: "is a placeholder"},
+	)
+	a := checks.Artifact{Data: []byte(data), Path: "g.csv"}
+	ctx, col := findings.WithCollector(context.Background())
+	out := runColumnRules(ctx, a, checks.RunOptions{})
+	want := `cells breaking column rules: term "log_in!" (row 3), description "TODO" (row 3), de_description "TODO" (row 3) (total 3)`
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant FAIL %q", out.Result.Status, out.Result.Message, want)
+	}
+	fds := col.Findings()
+	if len(fds) != 3 {
+		t.Fatalf("findings = %+v", fds)
+	}
+	if fds[0].Severity != checks.Fail || fds[0].Message != `"log_in!" does not match ^[\p{L}\d \-']+$` {
+		t.Errorf("term finding = %+v", fds[0])
+	}
+	if fds[1].Severity != checks.Warn || fds[1].Message != `"TODO" is a placeholder` {
+		t.Errorf("description finding = %+v", fds[1])
+	}
+}
+
+func TestRunColumnRulesWarnOnly(t *testing.T) {
+	setRules(t, config.ColumnRule{Column: "tags", MustMatch: `^[a-z]+$`, Severity: "warn"})
+	a := checks.Artifact{Data: []byte("term;description;tags\nx;d;Auth\ny;d;\n"), Path: "g.csv"}
+	out := runColumnRules(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
+
+func TestRunColumnRulesPass(t *testing.T) {
+	a := checks.Artifact{Data: []byte(data), Path: "g.csv"}
+	if out := runColumnRules(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Pass {
+		t.Fatalf("unconfigured: got %s %q", out.Result.Status, out.Result.Message)
+	}
+	setRules(t, config.ColumnRule{Column: "de", MustNotMatch: `_`})
+	if out := runColumnRules(context.Background(), a, checks.RunOptions{}); out.Result.Status != checks.Pass {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
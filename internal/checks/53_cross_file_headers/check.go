@@ -0,0 +1,126 @@
+package cross_file_headers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/batch"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-cross-file-headers"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runCrossFileHeaders,
+		checks.WithPriority(53),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-CROSS-FILE", Remediation: checkmeta.Remediation{
+		Hint: "Give every glossary of the batch the same columns in the same order.",
+	}})
+}
+
+func runCrossFileHeaders(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateCrossFileHeaders,
+		Fix:      nil,
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateCrossFileHeaders compares the header of a file with those of the
+// other files validated in the same run. The shape most files share is the
+// reference (the earliest file's on a tie); files that differ from it are
+// reported with the columns they miss or add, or the order they use.
+func validateCrossFileHeaders(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	files := batch.Files()
+	self := batch.Index(files, a.Path)
+	if len(files) < 2 || self < 0 {
+		return checks.ValidationResult{OK: true, Msg: "single file (skipping cross-file headers)"}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for cross-file headers"}
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping cross-file headers)"}
+	}
+
+	shapes := make([]string, len(files))
+	count := map[string]int{}
+	for i, f := range files {
+		shapes[i] = strings.Join(columns(f.Header), ";")
+		count[shapes[i]]++
+	}
+	ref := 0
+	for i := range files {
+		if count[shapes[i]] > count[shapes[ref]] {
+			ref = i
+		}
+	}
+	// The header as validated, after any fixes, is what counts for this file.
+	own := columns(tbl.Header)
+	want := columns(files[ref].Header)
+	if strings.Join(own, ";") == shapes[ref] {
+		return checks.ValidationResult{OK: true, Msg: fmt.Sprintf("same columns as %d of %d files", count[shapes[ref]], len(files))}
+	}
+
+	missing, extra := diff(want, own), diff(own, want)
+	var parts []string
+	if len(missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		parts = append(parts, "extra "+strings.Join(extra, ", "))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("column order %s, expected %s", strings.Join(own, ";"), shapes[ref]))
+	}
+	msg := fmt.Sprintf("columns differ from %d of %d files (like %s): %s",
+		count[shapes[ref]], len(files), files[ref].Path, strings.Join(parts, "; "))
+	findings.Report(ctx, []findings.Finding{findings.At(tbl.HeaderLine, "", tbl.HeaderOffset, strings.Join(parts, "; "))})
+	return checks.ValidationResult{OK: false, Msg: msg}
+}
+
+// columns normalizes a header for comparison, dropping empty cells.
+func columns(header []string) []string {
+	var out []string
+	for _, h := range header {
+		if n := csvutil.NormalizeHeader(h); n != "" {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// diff returns the columns of a that are not in b.
+func diff(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, c := range b {
+		in[c] = true
+	}
+	var out []string
+	for _, c := range a {
+		if !in[c] {
+			out = append(out, c)
+		}
+	}
+	return out
+}
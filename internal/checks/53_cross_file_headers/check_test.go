@@ -0,0 +1,46 @@
+package cross_file_headers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/batch"
+)
+
+func TestRunCrossFileHeaders(t *testing.T) {
+	batch.Set([]batch.File{
+		{Path: "a.csv", Header: []string{"term", "description", "de", "fr"}},
+		{Path: "b.csv", Header: []string{"Term", "Description", "DE", "FR", ""}},
+		{Path: "c.csv", Header: []string{"term", "description", "de", "it"}},
+		{Path: "d.csv", Header: []string{"term", "description", "fr", "de"}},
+	})
+	t.Cleanup(func() { batch.Set(nil) })
+
+	cases := []struct {
+		path, data string
+		status     checks.Status
+		msg        string
+	}{
+		{"b.csv", "Term;Description;DE;FR;\nx;y;z;w;\n", checks.Pass, "same columns as 2 of 4 files"},
+		{"c.csv", "term;description;de;it\n", checks.Warn, "columns differ from 2 of 4 files (like a.csv): missing fr; extra it"},
+		{"d.csv", "term;description;fr;de\n", checks.Warn, "columns differ from 2 of 4 files (like a.csv): column order term;description;fr;de, expected term;description;de;fr"},
+		{"other.csv", "term;x\n", checks.Pass, "single file (skipping cross-file headers)"},
+	}
+	for _, tc := range cases {
+		out := runCrossFileHeaders(context.Background(), checks.Artifact{Data: []byte(tc.data), Path: tc.path}, checks.RunOptions{})
+		if out.Result.Status != tc.status || out.Result.Message != tc.msg {
+			t.Errorf("%s: got %s %q\nwant %s %q", tc.path, out.Result.Status, out.Result.Message, tc.status, tc.msg)
+		}
+	}
+}
+
+func TestRunCrossFileHeadersSingleFile(t *testing.T) {
+	batch.Set([]batch.File{{Path: "a.csv", Header: []string{"term"}}})
+	t.Cleanup(func() { batch.Set(nil) })
+	out := runCrossFileHeaders(context.Background(), checks.Artifact{Data: []byte("other\n"), Path: "a.csv"}, checks.RunOptions{})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,104 @@
+package duplicate_languages
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "no-duplicate-language-columns"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runDuplicateLanguages,
+		checks.WithPriority(54),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-DUPLICATE-LANGUAGE", Remediation: checkmeta.Remediation{
+		Hint: "Merge columns naming the same language (pt-BR and pt_BR) into one, or fix the upload.locale-map entry mapping them together.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runDuplicateLanguages(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateDuplicateLanguages,
+		Fix:      nil,
+		PassMsg:  "each language has one column",
+		FailAs:   checks.Fail,
+	})
+}
+
+// validateDuplicateLanguages fails when two language columns are uploaded
+// as the same language: spelled alike ("en" twice, "EN" and "en"), with
+// another separator or case ("pt-BR" and "pt_br"), as aliases of one
+// language ("iw" and "he") or mapped together by upload.locale-map.
+func validateDuplicateLanguages(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for duplicate languages"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping duplicate languages)"}
+	}
+	localeMap := config.Get().Upload.LocaleMap
+
+	first := map[string]int{}
+	var dups []string
+	var fds []findings.Finding
+	for _, c := range tbl.LangCols() {
+		col := strings.TrimSpace(tbl.Header[c])
+		key := canonical(lokalise.LokaliseCode(col, localeMap))
+		prev, seen := first[key]
+		if !seen {
+			first[key] = c
+			continue
+		}
+		other := strings.TrimSpace(tbl.Header[prev])
+		dups = append(dups, fmt.Sprintf("%q = %q", other, col))
+		msg := fmt.Sprintf("%q is the same language as %q (column %d)", col, other, prev+1)
+		fds = append(fds, findings.At(tbl.HeaderLine, col, tbl.HeaderOffset, msg))
+	}
+	findings.Report(ctx, fds)
+
+	if len(dups) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "each language has one column"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "duplicate language columns: " + csvutil.JoinLimited(dups, "; ", 10),
+	}
+}
+
+// canonical returns the language a code stands for, so that spellings of
+// one language compare equal. Codes that do not parse compare by their
+// normalized spelling.
+func canonical(code string) string {
+	tag, err := language.Parse(strings.ReplaceAll(strings.TrimSpace(code), "_", "-"))
+	if err != nil || tag == language.Und {
+		return lokalise.NormalizeCode(code)
+	}
+	return strings.ToLower(tag.String())
+}
@@ -0,0 +1,44 @@
+package duplicate_languages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+func TestRunDuplicateLanguages(t *testing.T) {
+	cases := []struct {
+		name, data string
+		status     checks.Status
+		msg        string
+	}{
+		{"distinct", "term;description;en;pt;pt-BR;pt_PT;en_description\n", checks.Pass, "each language has one column"},
+		{"separators", "term;description;pt-BR;de;pt_br\n", checks.Fail, `duplicate language columns: "pt-BR" = "pt_br" (total 1)`},
+		{"same spelling", "term;description;en;de;EN\n", checks.Fail, `duplicate language columns: "en" = "EN" (total 1)`},
+		{"alias", "term;description;he;iw\n", checks.Fail, `duplicate language columns: "he" = "iw" (total 1)`},
+		{"unparsable", "term;description;klingon;Klingon\n", checks.Fail, `duplicate language columns: "klingon" = "Klingon" (total 1)`},
+		{"empty", "", checks.Pass, "each language has one column"},
+	}
+	for _, tc := range cases {
+		out := runDuplicateLanguages(context.Background(), checks.Artifact{Data: []byte(tc.data), Path: "g.csv"}, checks.RunOptions{})
+		if out.Result.Status != tc.status || out.Result.Message != tc.msg {
+			t.Errorf("%s: got %s %q\nwant %s %q", tc.name, out.Result.Status, out.Result.Message, tc.status, tc.msg)
+		}
+	}
+}
+
+func TestRunDuplicateLanguagesLocaleMap(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Upload.LocaleMap = map[string]string{"Deutsch": "de"}
+	config.Set(cfg)
+	t.Cleanup(func() { config.Set(nil) })
+
+	data := "term;description;de;Deutsch\n"
+	out := runDuplicateLanguages(context.Background(), checks.Artifact{Data: []byte(data), Path: "g.csv"}, checks.RunOptions{})
+	if out.Result.Status != checks.Fail || out.Result.Message != `duplicate language columns: "de" = "Deutsch" (total 1)` {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
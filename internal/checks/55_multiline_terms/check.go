@@ -0,0 +1,108 @@
+package multiline_terms
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "no-multiline-terms"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runMultilineTerms,
+		checks.WithPriority(55),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-MULTILINE", Remediation: checkmeta.Remediation{
+		Hint: "Join the lines of the reported cells; a line break inside a quoted term is usually a copy-paste or export error.",
+		Link: checkmeta.DocsBase + "#configuration",
+	}})
+}
+
+func runMultilineTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateMultilineTerms,
+		Fix:      nil,
+		FailAs:   checks.Fail,
+	})
+}
+
+// validateMultilineTerms fails terms, and with translations: true also
+// translations, holding a line break. Quoted CSV cells may span lines, but
+// a glossary entry never does. Descriptions are free text and not checked.
+func validateMultilineTerms(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for multi-line terms"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping multi-line terms)"}
+	}
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return checks.ValidationResult{OK: true, Msg: "no 'term' column found (skipping multi-line terms)"}
+	}
+	cols := []int{termCol}
+	what := "terms"
+	if config.Get().Checks.Multiline.Translations {
+		cols = append(cols, tbl.LangCols()...)
+		what = "terms and translations"
+	}
+
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		for _, c := range cols {
+			lines := countLines(row.Get(c))
+			if lines < 2 {
+				continue
+			}
+			col := strings.TrimSpace(tbl.Header[c])
+			bad = append(bad, fmt.Sprintf("row %d %s", row.Line, col))
+			fds = append(fds, findings.At(row.Line, col, row.Offset, fmt.Sprintf("cell spans %d lines", lines)))
+		}
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no line breaks in " + what}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "line breaks in " + what + ": " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// countLines returns the number of lines in s, counting "\r\n", "\r",
+// "\n" and the Unicode line and paragraph separators as breaks. Breaks at
+// either end are ignored: they are surrounding whitespace, reported by
+// warn-cell-whitespace.
+func countLines(s string) int {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return 1 + strings.Count(s, "\n") + strings.Count(s, "\r") +
+		strings.Count(s, "\u0085") + strings.Count(s, "\u2028") + strings.Count(s, "\u2029")
+}
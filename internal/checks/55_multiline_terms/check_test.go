@@ -0,0 +1,51 @@
+package multiline_terms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/config"
+)
+
+const data = "term;description;de\n" +
+	"\"log\nin\";\"first line\nsecond line\";anmelden\n" +
+	"file;d;\"Da\r\ntei\"\n" +
+	"\"upload\n\";d;hochladen\n" +
+	"folder\u2028name;d;Ordner\n"
+
+func TestRunMultilineTerms(t *testing.T) {
+	out := runMultilineTerms(context.Background(), checks.Artifact{Data: []byte(data), Path: "g.csv"}, checks.RunOptions{})
+	want := "line breaks in terms: row 2 term, row 9 term (total 2)"
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestRunMultilineTermsTranslations(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Checks.Multiline.Translations = true
+	config.Set(cfg)
+	t.Cleanup(func() { config.Set(nil) })
+
+	out := runMultilineTerms(context.Background(), checks.Artifact{Data: []byte(data), Path: "g.csv"}, checks.RunOptions{})
+	want := "line breaks in terms and translations: row 2 term, row 5 de, row 9 term (total 3)"
+	if out.Result.Status != checks.Fail || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant %q", out.Result.Status, out.Result.Message, want)
+	}
+
+	out = runMultilineTerms(context.Background(), checks.Artifact{Data: []byte("term;de\nfile;Datei\n"), Path: "g.csv"}, checks.RunOptions{})
+	if out.Result.Status != checks.Pass || out.Result.Message != "no line breaks in terms and translations" {
+		t.Fatalf("got %s %q", out.Result.Status, out.Result.Message)
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	cases := map[string]int{"": 0, "a": 1, "a\nb": 2, "a\r\nb": 2, "a\rb\nc": 3, "\na\n": 1, "a\u2029b": 2}
+	for s, want := range cases {
+		if got := countLines(s); got != want {
+			t.Errorf("countLines(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package duplicate_rows
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/checkmeta"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const checkName = "warn-duplicate-rows"
+
+func init() {
+	ch, err := checks.NewCheckAdapter(
+		checkName,
+		runDuplicateRows,
+		// Before warn-duplicate-term-values (13), whose fix drops every row
+		// repeating a term. Identical rows are harmless to remove, so they go
+		// first and only rows that really conflict are left to it.
+		checks.WithPriority(12),
+	)
+	if err != nil {
+		panic(checkName + ": " + err.Error())
+	}
+	if _, err := checks.Register(ch); err != nil {
+		panic(checkName + " register: " + err.Error())
+	}
+	checkmeta.Register(checkName, checkmeta.Meta{Code: "GG-DUPLICATE-ROW", Remediation: checkmeta.Remediation{
+		Hint: "Delete the repeated rows, or run with --fix to keep only the first of each.",
+	}, After: []string{"ensure-semicolon-separators"}})
+}
+
+func runDuplicateRows(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateDuplicateRows,
+		Fix:              fixDuplicateRows,
+		PassMsg:          "no duplicate rows",
+		FixedMsg:         "removed duplicate rows",
+		AppliedMsg:       "auto-fix applied: removed duplicate rows",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+	})
+}
+
+// validateDuplicateRows reports rows whose every cell equals an earlier
+// row's, such as rows pasted twice or files concatenated with overlap.
+func validateDuplicateRows(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+	if len(bytes.TrimSpace(a.Data)) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no content to validate for duplicate rows"}
+	}
+
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.ValidationResult{OK: true, Msg: "no parsable header (skipping duplicate rows)"}
+	}
+
+	first := map[string]int{}
+	var bad []string
+	var fds []findings.Finding
+	for _, row := range tbl.Rows {
+		key := csvutil.EncodeRecord(row.Cells)
+		orig, seen := first[key]
+		if !seen {
+			first[key] = row.Line
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("row %d = row %d", row.Line, orig))
+		fds = append(fds, findings.At(row.Line, "", row.Offset, fmt.Sprintf("same as row %d", orig)))
+	}
+	findings.Report(ctx, fds)
+
+	if len(bad) == 0 {
+		return checks.ValidationResult{OK: true, Msg: "no duplicate rows"}
+	}
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "duplicate rows: " + csvutil.JoinLimited(bad, ", ", 10),
+	}
+}
+
+// fixDuplicateRows deletes every row that repeats an earlier one, keeping
+// the first, and lists the deleted lines in the note.
+func fixDuplicateRows(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil || tbl == nil {
+		return checks.NoFix(a, "no parsable header")
+	}
+	seen := map[string]bool{}
+	out, removed, err := csvutil.Remove(a.Data, func(line int, rec []string) bool {
+		if line <= tbl.HeaderLine || !csvutil.AnyNonEmpty(rec) {
+			return false
+		}
+		key := csvutil.EncodeRecord(rec)
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+		return false
+	})
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if len(removed) == 0 {
+		return checks.NoFix(a, "no duplicate rows to remove")
+	}
+	lines := make([]string, len(removed))
+	for i, l := range removed {
+		lines[i] = strconv.Itoa(l)
+	}
+	note := "removed duplicate rows at line(s) " + csvutil.JoinLimited(lines, ", ", 20)
+	return checks.FixResult{Data: out, DidChange: true, Note: note}, nil
+}
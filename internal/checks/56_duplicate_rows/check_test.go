@@ -0,0 +1,34 @@
+package duplicate_rows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const data = "term;description;de\n" +
+	"file;a file;Datei\n" +
+	"folder;a folder;Ordner\n" +
+	"\"file\";a file;Datei\n" +
+	"file;a file;Akte\n" +
+	"file;a file;Datei\n"
+
+func TestRunDuplicateRows(t *testing.T) {
+	out := runDuplicateRows(context.Background(), checks.Artifact{Data: []byte(data), Path: "g.csv"}, checks.RunOptions{})
+	want := "duplicate rows: row 4 = row 2, row 6 = row 2 (total 2)"
+	if out.Result.Status != checks.Warn || out.Result.Message != want {
+		t.Fatalf("got %s %q\nwant WARN %q", out.Result.Status, out.Result.Message, want)
+	}
+}
+
+func TestFixDuplicateRows(t *testing.T) {
+	out := runDuplicateRows(context.Background(), checks.Artifact{Data: []byte(data), Path: "g.csv"}, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	want := "term;description;de\nfile;a file;Datei\nfolder;a folder;Ordner\nfile;a file;Akte\n"
+	if out.Result.Status != checks.Pass || string(out.Final.Data) != want {
+		t.Fatalf("got %s %q\n%q", out.Result.Status, out.Result.Message, out.Final.Data)
+	}
+	if got := out.Final.Note; got != "removed duplicate rows at line(s) 4, 6 (total 2)" {
+		t.Errorf("note = %q", got)
+	}
+}
@@ -0,0 +1,48 @@
+// Package all registers the CLI-side checks on top of the core set.
+package all
+
+import (
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/02_utf8_encoding"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/13_duplicate_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/16_term_casing"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/17_acronym_consistency"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/18_lokalise_limits"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/19_near_duplicates"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/20_untranslatable_translations"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/21_forbidden_translations"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/22_tag_format"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/23_language_codes"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/24_language_coverage"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/25_translation_coverage"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/26_description"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/27_term_length"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/28_cell_whitespace"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/29_inner_whitespace"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/30_unicode_normalization"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/31_invisible_characters"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/32_control_characters"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/33_mixed_script"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/34_typography"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/35_line_endings"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/36_bom_policy"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/37_formula_injection"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/38_markup"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/39_untranslated"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/40_term_count"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/41_column_count"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/42_quoting"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/43_no_letter_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/44_edge_punctuation"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/45_description_urls"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/46_html_entities"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/47_emoji"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/48_denylist"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/49_preferred_spelling"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/50_misspellings"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/51_missing_translations"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/52_column_rules"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/53_cross_file_headers"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/54_duplicate_languages"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/55_multiline_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/56_duplicate_rows"
+)
@@ -0,0 +1,445 @@
+// Package config loads the optional YAML configuration file and exposes it to
+// checks, which have no other way to receive per-run settings.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/lokalise"
+	"github.com/bodrovis/lokalise-glossary-guard/internal/policy"
+)
+
+// DefaultFile is picked up from the working directory when --config is not given.
+const DefaultFile = ".glossaryguard.yaml"
+
+// Config is the root of the configuration file.
+type Config struct {
+	Checks Checks `yaml:"checks"`
+	Upload Upload `yaml:"upload"`
+	// Policy is a shared bundle whose policy.yaml is layered over this file.
+	Policy policy.Source `yaml:"policy"`
+	Order  Order         `yaml:"order"`
+}
+
+// Order overrides the order checks, and so their fixes, run in. Keys and
+// values are check names or rule codes.
+type Order struct {
+	// Priorities replaces the priority of checks; lower runs earlier.
+	Priorities map[string]int `yaml:"priorities"`
+	// After adds to the checks each check must run after.
+	After map[string][]string `yaml:"after"`
+}
+
+// Upload configures the upload command.
+type Upload struct {
+	// ProjectID is used when --project-id is not given.
+	ProjectID string `yaml:"project-id"`
+	// LocaleMap maps CSV language columns to Lokalise language codes when
+	// they differ beyond "-" vs "_" (e.g. "de": "de_DE").
+	LocaleMap map[string]string `yaml:"locale-map"`
+}
+
+// Checks holds per-check settings keyed by check name.
+type Checks struct {
+	TermCasing TermCasing `yaml:"warn-term-casing"`
+	// LokaliseLimits overrides individual values of lokalise.DefaultLimits.
+	LokaliseLimits  lokalise.Limits `yaml:"lokalise-limits"`
+	NearDuplicates  NearDuplicates  `yaml:"warn-near-duplicate-terms"`
+	Tags            Tags            `yaml:"warn-tag-format"`
+	LanguageCodes   LanguageCodes   `yaml:"warn-language-codes"`
+	Coverage        Coverage        `yaml:"warn-translation-coverage"`
+	TermLength      TermLength      `yaml:"warn-long-terms"`
+	CellWhitespace  Whitespace      `yaml:"warn-cell-whitespace"`
+	InnerWhitespace Whitespace      `yaml:"warn-inner-whitespace"`
+	Typography      Typography      `yaml:"warn-typography"`
+	BOM             BOM             `yaml:"bom-policy"`
+	Untranslated    Untranslated    `yaml:"warn-untranslated"`
+	TermCount       TermCount       `yaml:"term-count"`
+	EdgePunct       EdgePunct       `yaml:"warn-edge-punctuation"`
+	URLs            URLs            `yaml:"warn-description-urls"`
+	Emoji           Emoji           `yaml:"emoji-in-terms"`
+	Denylist        Denylist        `yaml:"no-denylisted-terms"`
+	Spelling        Spelling        `yaml:"warn-preferred-spelling"`
+	Spellcheck      Spellcheck      `yaml:"warn-misspellings"`
+	ColumnRules     []ColumnRule    `yaml:"column-rules"`
+	Multiline       Multiline       `yaml:"no-multiline-terms"`
+	DuplicateTerms  DuplicateTerms  `yaml:"warn-duplicate-term-values"`
+	Acronyms        Acronyms        `yaml:"acronyms"`
+}
+
+// TermCasing configures the term casing policy check.
+type TermCasing struct {
+	// Policy is one of: lowercase, lowercase-unless-proper-noun,
+	// sentence-case, title-case, no-all-caps. Empty disables the check.
+	Policy string `yaml:"policy"`
+	// Exceptions are words (proper nouns, acronyms) exempt from the policy.
+	Exceptions []string `yaml:"exceptions"`
+}
+
+// NearDuplicates configures the near-duplicate term check.
+type NearDuplicates struct {
+	// Metric is levenshtein (default) or jaro-winkler.
+	Metric string `yaml:"metric"`
+	// Threshold is the similarity (0-1) at or above which two terms are
+	// reported. Zero uses the metric's default.
+	Threshold float64 `yaml:"threshold"`
+	// Ignore lists terms that are never reported as near-duplicates.
+	Ignore []string `yaml:"ignore"`
+}
+
+// Acronyms configures the acronym consistency check.
+type Acronyms struct {
+	// Ignore lists acronyms that are never reported, matched regardless of
+	// case and dots (e.g. "IT" also covers "it" and "I.T.").
+	Ignore []string `yaml:"ignore"`
+}
+
+// Tags configures the tag format check.
+type Tags struct {
+	// Allowed, when set, is the complete list of tags terms may use.
+	Allowed []string `yaml:"allowed"`
+}
+
+// LanguageCodes configures the language code check.
+type LanguageCodes struct {
+	// Lokalise also requires every code to be a language Lokalise supports.
+	// The list is fetched with LOKALISE_API_TOKEN and cached for a day.
+	Lokalise bool `yaml:"lokalise"`
+}
+
+// Coverage configures the per-language translation coverage check.
+type Coverage struct {
+	// MinPercent is the share of rows (0-100) that must have a translation
+	// in every language column. With neither it nor Languages set the check
+	// is skipped; with only Languages, only those languages are checked.
+	MinPercent *float64 `yaml:"min-percent"`
+	// Languages overrides MinPercent per language column.
+	Languages map[string]float64 `yaml:"languages"`
+}
+
+// TermLength configures the long term check.
+type TermLength struct {
+	// MaxLength is the longest term, in characters, that is not reported.
+	// Zero means 50.
+	MaxLength int `yaml:"max-length"`
+}
+
+// Whitespace configures the whitespace checks and their fixes.
+type Whitespace struct {
+	// SkipColumns lists header names, matched case-insensitively, that are
+	// neither checked nor fixed; * and ? match several ("*_description").
+	SkipColumns []string `yaml:"skip-columns"`
+}
+
+// Skips reports whether the column named header is in SkipColumns.
+func (w Whitespace) Skips(header string) bool {
+	h := strings.ToLower(strings.TrimSpace(header))
+	for _, p := range w.SkipColumns {
+		if ok, _ := path.Match(strings.ToLower(strings.TrimSpace(p)), h); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Typography configures the quotes and dashes style check.
+type Typography struct {
+	// Style is straight (' " -) or typographic (’ “ ” –). Empty disables the check.
+	Style string `yaml:"style"`
+	// Translations also checks and fixes the language columns.
+	Translations bool `yaml:"translations"`
+}
+
+// BOM configures the UTF-8 byte order mark policy check.
+type BOM struct {
+	// Policy is require-bom, forbid-bom or allow. Empty means allow.
+	Policy string `yaml:"policy"`
+}
+
+// Untranslated configures the untranslated translation check.
+type Untranslated struct {
+	// IgnoreLanguages lists language columns where a translation equal to
+	// the term is normal (e.g. brand-heavy or closely related languages).
+	IgnoreLanguages []string `yaml:"ignore-languages"`
+}
+
+// TermCount configures the term count check. The hard limit is
+// lokalise-limits max_terms.
+type TermCount struct {
+	// WarnAt is the number of terms from which a warning is given before
+	// the hard limit is reached. Zero means 90% of max_terms.
+	WarnAt int `yaml:"warn-at"`
+}
+
+// EdgePunct configures the edge punctuation check.
+type EdgePunct struct {
+	// Allow lists terms that legitimately start or end with punctuation
+	// (e.g. "C#", ".NET").
+	Allow []string `yaml:"allow"`
+}
+
+// URLs configures the description URL check.
+type URLs struct {
+	// CheckLinks also requests every URL (HEAD, falling back to GET) and
+	// reports those that fail. Set by validate --check-links as well.
+	CheckLinks bool `yaml:"check-links"`
+}
+
+// Emoji configures the emoji in terms check.
+type Emoji struct {
+	// Strict fails instead of warning.
+	Strict bool `yaml:"strict"`
+}
+
+// Denylist configures the denylisted terms check.
+type Denylist struct {
+	// File holds one pattern per line: plain text, or a regular expression
+	// between slashes. A relative path is resolved against the directory of
+	// the config or policy file that sets it. Empty disables the check.
+	File string `yaml:"file"`
+}
+
+// Spelling configures the preferred spelling check.
+type Spelling struct {
+	// Replace maps deprecated spellings to approved ones ("e-mail": "email").
+	Replace map[string]string `yaml:"replace"`
+	// File is a YAML file with more mappings of the same form, resolved
+	// like Denylist.File. Entries of Replace win.
+	File string `yaml:"file"`
+}
+
+// Spellcheck configures the Hunspell spell check.
+type Spellcheck struct {
+	// Dictionaries maps "term" and language columns to Hunspell
+	// dictionaries, given as paths without the .aff/.dic extension and
+	// resolved like Denylist.File. Columns without one are not checked;
+	// no dictionaries disables the check.
+	Dictionaries map[string]string `yaml:"dictionaries"`
+	// Ignore lists words accepted in every column (product names, jargon).
+	Ignore []string `yaml:"ignore"`
+}
+
+// ColumnRule is a regular expression constraint on the cells of columns.
+type ColumnRule struct {
+	// Column is a header name, matched case-insensitively; * and ? match
+	// several columns ("*_description").
+	Column string `yaml:"column"`
+	// MustMatch, when set, must match every non-empty cell.
+	MustMatch string `yaml:"must-match"`
+	// MustNotMatch, when set, must not match any cell.
+	MustNotMatch string `yaml:"must-not-match"`
+	// Severity is fail (default) or warn.
+	Severity string `yaml:"severity"`
+	// Message explains the rule in findings; defaults to the pattern.
+	Message string `yaml:"message"`
+}
+
+// Multiline configures the multi-line terms check.
+type Multiline struct {
+	// Translations also checks the language columns.
+	Translations bool `yaml:"translations"`
+}
+
+// DuplicateTerms configures how --fix merges rows repeating a term.
+type DuplicateTerms struct {
+	// Strategy is keep-first (default), keep-longest-description or
+	// union-translations.
+	Strategy string `yaml:"strategy"`
+}
+
+var current atomic.Pointer[Config]
+
+// Get returns the active configuration (never nil).
+func Get() *Config {
+	if c := current.Load(); c != nil {
+		return c
+	}
+	return &Config{}
+}
+
+// Annotation marks a command that reads the configuration. The root command
+// loads it, and fetches the policy bundle, only before such commands, so
+// that the others work offline whatever the config says.
+const Annotation = "glossary-guard/config"
+
+// Set replaces the active configuration.
+func Set(c *Config) {
+	current.Store(c)
+}
+
+// Load reads the config from path. An empty path falls back to DefaultFile,
+// which may be absent; an explicitly given path must exist.
+func Load(path string) (*Config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = DefaultFile
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return &Config{}, nil
+		}
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	var c Config
+	if err := yaml.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	c.resolvePaths(filepath.Dir(path))
+	return &c, nil
+}
+
+// Overlay reads the policy file at path on top of c: every setting the policy
+// has wins, the rest of c is kept. A policy cannot point at another policy.
+func (c *Config) Overlay(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read policy: %w", err)
+	}
+	src := c.Policy
+	// Files the policy refers to live in its bundle, so only paths the
+	// policy sets are resolved against it.
+	local := map[string]string{}
+	c.eachPath(func(key string, p *string) {
+		local[key] = *p
+		*p = ""
+	})
+	if err := yaml.Unmarshal(raw, c); err != nil {
+		return fmt.Errorf("parse policy %s: %w", path, err)
+	}
+	c.Policy = src
+	if err := c.validate(); err != nil {
+		return fmt.Errorf("policy %s: %w", path, err)
+	}
+	c.resolvePaths(filepath.Dir(path))
+	c.eachPath(func(key string, p *string) {
+		if *p == "" {
+			*p = local[key]
+		}
+	})
+	return nil
+}
+
+// eachPath calls fn with every setting of c that names a file, keyed by
+// where it is set.
+func (c *Config) eachPath(fn func(key string, p *string)) {
+	fn("denylist", &c.Checks.Denylist.File)
+	fn("spelling", &c.Checks.Spelling.File)
+	for col, p := range c.Checks.Spellcheck.Dictionaries {
+		fn("dictionaries."+col, &p)
+		c.Checks.Spellcheck.Dictionaries[col] = p
+	}
+}
+
+// resolvePaths makes the relative file paths of c relative to dir.
+func (c *Config) resolvePaths(dir string) {
+	c.eachPath(func(_ string, p *string) {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	})
+}
+
+func (c *Config) validate() error {
+	switch c.Checks.TermCasing.Policy {
+	case "", "lowercase", "lowercase-unless-proper-noun", "sentence-case", "title-case", "no-all-caps":
+	default:
+		return fmt.Errorf("warn-term-casing: unknown policy %q", c.Checks.TermCasing.Policy)
+	}
+	nd := c.Checks.NearDuplicates
+	switch nd.Metric {
+	case "", "levenshtein", "jaro-winkler":
+	default:
+		return fmt.Errorf("warn-near-duplicate-terms: unknown metric %q", nd.Metric)
+	}
+	if nd.Threshold < 0 || nd.Threshold > 1 {
+		return fmt.Errorf("warn-near-duplicate-terms: threshold %v is not between 0 and 1", nd.Threshold)
+	}
+	switch c.Checks.Typography.Style {
+	case "", "straight", "typographic":
+	default:
+		return fmt.Errorf("warn-typography: unknown style %q", c.Checks.Typography.Style)
+	}
+	switch c.Checks.DuplicateTerms.Strategy {
+	case "", "keep-first", "keep-longest-description", "union-translations":
+	default:
+		return fmt.Errorf("warn-duplicate-term-values: unknown strategy %q", c.Checks.DuplicateTerms.Strategy)
+	}
+	switch c.Checks.BOM.Policy {
+	case "", "allow", "require-bom", "forbid-bom":
+	default:
+		return fmt.Errorf("bom-policy: unknown policy %q", c.Checks.BOM.Policy)
+	}
+	if c.Checks.TermCount.WarnAt < 0 {
+		return fmt.Errorf("term-count: warn-at %d is negative", c.Checks.TermCount.WarnAt)
+	}
+	if c.Checks.TermLength.MaxLength < 0 {
+		return fmt.Errorf("warn-long-terms: max-length %d is negative", c.Checks.TermLength.MaxLength)
+	}
+	for _, ws := range []struct {
+		key string
+		w   Whitespace
+	}{{"warn-cell-whitespace", c.Checks.CellWhitespace}, {"warn-inner-whitespace", c.Checks.InnerWhitespace}} {
+		for _, p := range ws.w.SkipColumns {
+			if _, err := path.Match(strings.ToLower(p), ""); err != nil {
+				return fmt.Errorf("%s: skip-columns %q: %w", ws.key, p, err)
+			}
+		}
+	}
+	for i, r := range c.Checks.ColumnRules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("column-rules[%d]: %w", i, err)
+		}
+	}
+	cov := c.Checks.Coverage
+	if cov.MinPercent != nil && (*cov.MinPercent < 0 || *cov.MinPercent > 100) {
+		return fmt.Errorf("warn-translation-coverage: min-percent %v is not between 0 and 100", *cov.MinPercent)
+	}
+	for lang, p := range cov.Languages {
+		if p < 0 || p > 100 {
+			return fmt.Errorf("warn-translation-coverage: %s: %v is not between 0 and 100", lang, p)
+		}
+	}
+	return nil
+}
+
+func (r ColumnRule) validate() error {
+	if strings.TrimSpace(r.Column) == "" {
+		return errors.New("column is required")
+	}
+	if _, err := path.Match(strings.ToLower(r.Column), ""); err != nil {
+		return fmt.Errorf("column %q: %w", r.Column, err)
+	}
+	if r.MustMatch == "" && r.MustNotMatch == "" {
+		return errors.New("must-match or must-not-match is required")
+	}
+	for _, expr := range []string{r.MustMatch, r.MustNotMatch} {
+		if _, err := regexp.Compile(expr); err != nil {
+			return err
+		}
+	}
+	switch r.Severity {
+	case "", "fail", "warn":
+	default:
+		return fmt.Errorf("unknown severity %q", r.Severity)
+	}
+	return nil
+}
+
+// Limits returns the effective Lokalise limits (defaults plus overrides).
+func (c *Config) Limits() lokalise.Limits {
+	return lokalise.DefaultLimits.Merge(c.Checks.LokaliseLimits)
+}
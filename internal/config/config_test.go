@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFilePathsResolveAgainstTheirConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "glossaryguard.yaml")
+	cfg := "checks:\n" +
+		"  no-denylisted-terms:\n    file: lists/deny.txt\n" +
+		"  warn-misspellings:\n    dictionaries:\n      term: dicts/en_US\n      de: /abs/de_DE\n"
+	if err := os.WriteFile(cfgPath, []byte(cfg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Load(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Checks.Denylist.File, filepath.Join(dir, "lists", "deny.txt"); got != want {
+		t.Errorf("denylist = %q, want %q", got, want)
+	}
+	if got, want := c.Checks.Spellcheck.Dictionaries["term"], filepath.Join(dir, "dicts", "en_US"); got != want {
+		t.Errorf("term dictionary = %q, want %q", got, want)
+	}
+	if got := c.Checks.Spellcheck.Dictionaries["de"]; got != "/abs/de_DE" {
+		t.Errorf("absolute path changed to %q", got)
+	}
+
+	bundle := t.TempDir()
+	polPath := filepath.Join(bundle, "policy.yaml")
+	pol := "checks:\n" +
+		"  warn-preferred-spelling:\n    file: spellings.yaml\n" +
+		"  warn-misspellings:\n    dictionaries:\n      de: de_DE\n"
+	if err := os.WriteFile(polPath, []byte(pol), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Overlay(polPath); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Checks.Spelling.File, filepath.Join(bundle, "spellings.yaml"); got != want {
+		t.Errorf("policy spelling file = %q, want %q", got, want)
+	}
+	if got, want := c.Checks.Spellcheck.Dictionaries["de"], filepath.Join(bundle, "de_DE"); got != want {
+		t.Errorf("policy de dictionary = %q, want %q", got, want)
+	}
+	if got, want := c.Checks.Spellcheck.Dictionaries["term"], filepath.Join(dir, "dicts", "en_US"); got != want {
+		t.Errorf("local term dictionary = %q, want %q (kept from the local file)", got, want)
+	}
+	if got, want := c.Checks.Denylist.File, filepath.Join(dir, "lists", "deny.txt"); got != want {
+		t.Errorf("local denylist = %q, want %q", got, want)
+	}
+}
+
+func TestColumnRulesAreValidated(t *testing.T) {
+	cases := map[string]string{
+		"column-rules:\n    - column: term\n":                                            "must-match or must-not-match is required",
+		"column-rules:\n    - must-match: x\n":                                           "column is required",
+		"column-rules:\n    - column: term\n      must-match: '('\n":                     "missing closing )",
+		"column-rules:\n    - column: term\n      must-match: x\n      severity: info\n": `unknown severity "info"`,
+	}
+	for yaml, want := range cases {
+		p := filepath.Join(t.TempDir(), "c.yaml")
+		if err := os.WriteFile(p, []byte("checks:\n  "+yaml), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := Load(p)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: err = %v, want %q", yaml, err, want)
+		}
+	}
+}
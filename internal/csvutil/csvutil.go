@@ -0,0 +1,182 @@
+// Package csvutil holds the semicolon-separated CSV parsing shared by the
+// CLI-side checks and reports.
+package csvutil
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Row is a single data record together with the 1-based line it starts on
+// and the byte offset of that line in the original data (BOM included).
+type Row struct {
+	Line   int
+	Offset int64
+	Cells  []string
+}
+
+// Get returns the cell at index i, or "" if the row is shorter.
+func (r Row) Get(i int) string {
+	if i < 0 || i >= len(r.Cells) {
+		return ""
+	}
+	return r.Cells[i]
+}
+
+// Table is a parsed glossary: the first non-blank record is the header,
+// the remaining non-blank records are rows.
+type Table struct {
+	Header       []string
+	HeaderLine   int
+	HeaderOffset int64
+	Rows         []Row
+}
+
+// Parse reads semicolon-separated data leniently (lazy quotes, ragged rows).
+// A leading UTF-8 BOM is ignored. Blank records are skipped.
+// A nil table with nil error means there was no header at all.
+func Parse(data []byte) (*Table, error) {
+	base := int64(0)
+	if bytes.HasPrefix(data, utf8BOM) {
+		data = data[len(utf8BOM):]
+		base = int64(len(utf8BOM))
+	}
+	starts := LineStarts(data)
+	offset := func(line int) int64 {
+		if line < 1 || line > len(starts) {
+			return base
+		}
+		return base + starts[line-1]
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = ';'
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	var t *Table
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return t, err
+		}
+		if !AnyNonEmpty(rec) {
+			continue
+		}
+		line, _ := r.FieldPos(0)
+		if t == nil {
+			t = &Table{Header: rec, HeaderLine: line, HeaderOffset: offset(line)}
+			continue
+		}
+		t.Rows = append(t.Rows, Row{Line: line, Offset: offset(line), Cells: rec})
+	}
+	return t, nil
+}
+
+// ReadHeader returns the first non-blank record of semicolon-separated
+// data, reading no further. A leading UTF-8 BOM is ignored; nil means there
+// is no header.
+func ReadHeader(rd io.Reader) ([]string, error) {
+	br := bufio.NewReader(rd)
+	if b, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(b, utf8BOM) {
+		_, _ = br.Discard(len(utf8BOM))
+	}
+	r := csv.NewReader(br)
+	r.Comma = ';'
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if AnyNonEmpty(rec) {
+			return rec, nil
+		}
+	}
+}
+
+// LineStarts returns the byte offset at which each line of data begins;
+// index 0 is line 1.
+func LineStarts(data []byte) []int64 {
+	starts := []int64{0}
+	for i, b := range data {
+		if b == '\n' && i+1 < len(data) {
+			starts = append(starts, int64(i+1))
+		}
+	}
+	return starts
+}
+
+// Col returns the index of the header column matching name
+// (trimmed, case-insensitive) or -1.
+func (t *Table) Col(name string) int {
+	name = NormalizeHeader(name)
+	for i, h := range t.Header {
+		if NormalizeHeader(h) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// LangCols returns the indexes of language columns: everything that is not a
+// known service column and not a <lang>_description column.
+func (t *Table) LangCols() []int {
+	var out []int
+	for i, h := range t.Header {
+		n := NormalizeHeader(h)
+		if n == "" || strings.HasSuffix(n, "_description") {
+			continue
+		}
+		if _, known := checks.KnownHeaders[n]; known {
+			continue
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// NormalizeHeader trims and lowercases a header cell.
+func NormalizeHeader(h string) string {
+	return strings.ToLower(strings.TrimSpace(h))
+}
+
+// AnyNonEmpty reports whether at least one cell has non-space content.
+func AnyNonEmpty(rec []string) bool {
+	for _, v := range rec {
+		if strings.TrimSpace(v) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinLimited joins at most limit items with sep and appends the total count,
+// e.g. "a; b ... (total 12)".
+func JoinLimited(items []string, sep string, limit int) string {
+	shown := items
+	if len(shown) > limit {
+		shown = shown[:limit]
+	}
+	s := strings.Join(shown, sep)
+	if len(items) > limit {
+		s += " ..."
+	}
+	return s + " (total " + strconv.Itoa(len(items)) + ")"
+}
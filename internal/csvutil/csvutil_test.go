@@ -0,0 +1,54 @@
+package csvutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	data := "\xEF\xBB\xBFterm;Description;en\n\nhello;\"multi\nline\";hi\n;;\nshort\n"
+
+	tbl, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if tbl.HeaderLine != 1 || tbl.Header[0] != "term" {
+		t.Fatalf("unexpected header %q at line %d", tbl.Header, tbl.HeaderLine)
+	}
+	if len(tbl.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(tbl.Rows))
+	}
+	if tbl.Rows[0].Line != 3 || tbl.Rows[1].Line != 6 {
+		t.Fatalf("unexpected row lines %d, %d", tbl.Rows[0].Line, tbl.Rows[1].Line)
+	}
+	// offsets count the BOM
+	if tbl.HeaderOffset != 3 || tbl.Rows[0].Offset != 24 || tbl.Rows[1].Offset != 49 {
+		t.Fatalf("unexpected offsets %d, %d, %d", tbl.HeaderOffset, tbl.Rows[0].Offset, tbl.Rows[1].Offset)
+	}
+	if got := tbl.Rows[1].Get(2); got != "" {
+		t.Fatalf("Get past end = %q, want empty", got)
+	}
+	if tbl.Col(" DESCRIPTION ") != 1 || tbl.Col("fr") != -1 {
+		t.Fatal("Col lookup mismatch")
+	}
+	if lc := tbl.LangCols(); len(lc) != 1 || lc[0] != 2 {
+		t.Fatalf("LangCols = %v, want [2]", lc)
+	}
+}
+
+func TestParse_NoHeader(t *testing.T) {
+	tbl, err := Parse([]byte("\n  \n"))
+	if err != nil || tbl != nil {
+		t.Fatalf("Parse(blank) = %v, %v; want nil, nil", tbl, err)
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	h, err := ReadHeader(strings.NewReader("\xEF\xBB\xBF\n;;\n\"term\";de\nsave;\"unclosed\n"))
+	if err != nil || len(h) != 2 || h[0] != "term" || h[1] != "de" {
+		t.Fatalf("ReadHeader = %q, %v", h, err)
+	}
+	if h, err := ReadHeader(strings.NewReader(" \n")); h != nil || err != nil {
+		t.Fatalf("ReadHeader(blank) = %q, %v; want nil, nil", h, err)
+	}
+}
@@ -0,0 +1,127 @@
+package csvutil
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Rewrite passes every record of semicolon-separated data to edit, with the
+// 1-based line it starts on. edit changes cells in place and reports whether
+// it changed any. Records left alone keep their original bytes, so a fix
+// only shows up where it happened; changed records are written back with
+// cells quoted only where needed. A leading BOM and the line endings are
+// kept. changed is false when edit changed nothing.
+func Rewrite(data []byte, edit func(line int, rec []string) bool) (out []byte, changed bool, err error) {
+	return Replace(data, func(line int, rec []string) ([]string, bool) {
+		return rec, edit(line, rec)
+	})
+}
+
+// Replace is Rewrite for edits that add or drop cells: fn returns the new
+// record and whether to use it. A nil record deletes the original one with
+// its line break.
+func Replace(data []byte, fn func(line int, rec []string) ([]string, bool)) (out []byte, changed bool, err error) {
+	return splice(data, func(line int, rec []string, raw []byte) ([]byte, bool) {
+		repl, ok := fn(line, rec)
+		switch {
+		case !ok:
+			return nil, false
+		case repl == nil:
+			return []byte{}, true
+		}
+		return []byte(EncodeRecord(repl) + lineEnding(raw)), true
+	})
+}
+
+// Remove deletes the records of semicolon-separated data that drop reports,
+// each with its line break, and returns the lines they started on. Everything
+// else keeps its original bytes.
+func Remove(data []byte, drop func(line int, rec []string) bool) (out []byte, removed []int, err error) {
+	out, _, err = Replace(data, func(line int, rec []string) ([]string, bool) {
+		if !drop(line, rec) {
+			return nil, false
+		}
+		removed = append(removed, line)
+		return nil, true
+	})
+	return out, removed, err
+}
+
+// splice replaces the bytes of each record (from its first field to its
+// line break) for which fn returns true. raw holds the record's original
+// bytes.
+func splice(data []byte, fn func(line int, rec []string, raw []byte) ([]byte, bool)) ([]byte, bool, error) {
+	body := bytes.TrimPrefix(data, utf8BOM)
+	bom := data[:len(data)-len(body)]
+	starts := LineStarts(body)
+
+	r := csv.NewReader(bytes.NewReader(body))
+	r.Comma = ';'
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	var buf bytes.Buffer
+	buf.Write(bom)
+	changed := false
+	prev := int64(0) // end of the last record copied to buf
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		end := r.InputOffset()
+		line, col := r.FieldPos(0)
+		start := starts[line-1] + int64(col-1)
+		repl, ok := fn(line, rec, body[start:end])
+		if !ok {
+			continue
+		}
+		changed = true
+		buf.Write(body[prev:start])
+		buf.Write(repl)
+		prev = end
+	}
+	if !changed {
+		return data, false, nil
+	}
+	buf.Write(body[prev:])
+	return buf.Bytes(), true, nil
+}
+
+// EncodeRecord joins cells with semicolons, quoting the cells that contain
+// a semicolon, a quote or a line break.
+func EncodeRecord(cells []string) string {
+	var b strings.Builder
+	for i, c := range cells {
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		if !strings.ContainsAny(c, ";\"\r\n") {
+			b.WriteString(c)
+			continue
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.ReplaceAll(c, `"`, `""`))
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+// lineEnding returns the line break that ends a record's bytes, if any.
+func lineEnding(rec []byte) string {
+	switch {
+	case bytes.HasSuffix(rec, []byte("\r\n")):
+		return "\r\n"
+	case bytes.HasSuffix(rec, []byte("\n")):
+		return "\n"
+	case bytes.HasSuffix(rec, []byte("\r")):
+		return "\r"
+	}
+	return ""
+}
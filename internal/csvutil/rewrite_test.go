@@ -0,0 +1,76 @@
+package csvutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRewrite(t *testing.T) {
+	data := "\ufeffterm;description;de\r\n" +
+		"\"log in\";\"keep \"\"as is\"\"\";anmelden\r\n" +
+		"\r\n" +
+		" file ;\"a\nfile\";Datei\r\n" +
+		"folder;x;Ordner"
+	out, changed, err := Rewrite([]byte(data), func(line int, rec []string) bool {
+		if line != 4 && line != 6 {
+			return false
+		}
+		rec[0] = strings.TrimSpace(rec[0]) + ";"
+		return true
+	})
+	if err != nil || !changed {
+		t.Fatalf("changed=%v err=%v", changed, err)
+	}
+	want := "\ufeffterm;description;de\r\n" +
+		"\"log in\";\"keep \"\"as is\"\"\";anmelden\r\n" +
+		"\r\n" +
+		"\"file;\";\"a\nfile\";Datei\r\n" +
+		"\"folder;\";x;Ordner"
+	if string(out) != want {
+		t.Fatalf("got  %q\nwant %q", out, want)
+	}
+
+	out, changed, err = Rewrite([]byte(data), func(int, []string) bool { return false })
+	if err != nil || changed || string(out) != data {
+		t.Fatalf("unchanged rewrite: changed=%v err=%v\n%q", changed, err, out)
+	}
+}
+
+func TestEncodeRecord(t *testing.T) {
+	got := EncodeRecord([]string{"plain", "", "semi;colon", `say "hi"`, " lead", "two\nlines"})
+	want := `plain;;"semi;colon";"say ""hi"""; lead;"two` + "\n" + `lines"`
+	if got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	data := "\ufeffterm;description\r\nfile;a\r\n\r\n\"multi\nline\";b\r\nfolder;c"
+	out, removed, err := Remove([]byte(data), func(line int, rec []string) bool {
+		return rec[0] == "multi\nline" || rec[0] == "folder"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "\ufeffterm;description\r\nfile;a\r\n\r\n"
+	if string(out) != want || len(removed) != 2 || removed[0] != 4 || removed[1] != 6 {
+		t.Fatalf("got %q, removed %v", out, removed)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	data := "term;en;tags\r\nfile;\"a;b\";x\r\n"
+	out, changed, err := Replace([]byte(data), func(line int, rec []string) ([]string, bool) {
+		if line == 1 {
+			return []string{"term", "en", "de", "tags"}, true
+		}
+		return append(rec[:2:2], "", rec[2]), true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "term;en;de;tags\r\nfile;\"a;b\";;x\r\n"
+	if !changed || string(out) != want {
+		t.Fatalf("got %q (changed %v), want %q", out, changed, want)
+	}
+}
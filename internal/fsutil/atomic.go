@@ -0,0 +1,106 @@
+// Package fsutil contains small file system helpers shared by commands.
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// AtomicFile writes to a temp file next to the target and renames it into
+// place on Commit, so readers never observe a half-written file.
+type AtomicFile struct {
+	tmp    *os.File
+	path   string
+	perm   os.FileMode
+	err    error
+	closed bool
+}
+
+// CreateAtomic prepares an atomic write to path, creating parent directories.
+func CreateAtomic(path string, perm os.FileMode) (*AtomicFile, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicFile{tmp: tmp, path: path, perm: perm}, nil
+}
+
+// Write implements io.Writer. The first error is sticky and reported by Commit.
+func (f *AtomicFile) Write(p []byte) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	n, err := f.tmp.Write(p)
+	f.err = err
+	return n, err
+}
+
+// Commit flushes the data to disk, renames the temp file over the target and
+// syncs the directory so the rename survives a crash. On any error the temp
+// file is removed and the target is left untouched.
+func (f *AtomicFile) Commit() error {
+	if f.closed {
+		return f.err
+	}
+	f.closed = true
+	name := f.tmp.Name()
+
+	err := f.err
+	if err == nil {
+		err = f.tmp.Chmod(f.perm)
+	}
+	if err == nil {
+		err = f.tmp.Sync()
+	}
+	if cerr := f.tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(name, f.path)
+	}
+	if err != nil {
+		_ = os.Remove(name)
+		f.err = err
+		return err
+	}
+	syncDir(filepath.Dir(f.path))
+	return nil
+}
+
+// syncDir flushes a directory entry change to disk. It is best effort: some
+// platforms (Windows) and file systems cannot sync directories.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	_ = d.Sync()
+	_ = d.Close()
+}
+
+// Abort discards the temp file. It is a no-op after Commit.
+func (f *AtomicFile) Abort() {
+	if f.closed {
+		return
+	}
+	f.closed = true
+	_ = f.tmp.Close()
+	_ = os.Remove(f.tmp.Name())
+}
+
+// WriteFile atomically replaces path with data.
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	f, err := CreateAtomic(path, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Abort()
+		return err
+	}
+	return f.Commit()
+}
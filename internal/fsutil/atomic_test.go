@@ -0,0 +1,43 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile_CreatesDirsAndReplaces(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a", "b", "out.txt")
+	if err := WriteFile(path, []byte("one"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(path, []byte("two"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil || string(got) != "two" {
+		t.Fatalf("content = %q, %v; want %q", got, err, "two")
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Fatalf("leftover temp files: %v", entries)
+	}
+}
+
+func TestAtomicFile_AbortKeepsTarget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.txt")
+	if err := os.WriteFile(path, []byte("orig"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := CreateAtomic(path, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Write([]byte("partial"))
+	f.Abort()
+
+	got, _ := os.ReadFile(path)
+	if string(got) != "orig" {
+		t.Fatalf("target changed after Abort: %q", got)
+	}
+}
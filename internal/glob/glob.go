@@ -0,0 +1,118 @@
+// Package glob matches slash-separated paths against patterns in which
+// "**" stands for any number of directories, as in .gitignore files and
+// most CI configurations. Other pattern syntax is that of path.Match.
+package glob
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Validate reports a malformed pattern.
+func Validate(pattern string) error {
+	for _, seg := range strings.Split(pattern, "/") {
+		if seg == "**" {
+			continue
+		}
+		if _, err := path.Match(seg, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match reports whether name matches pattern. Both use "/" as separator;
+// "**" as a whole segment matches zero or more segments.
+func Match(pattern, name string) bool {
+	return match(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func match(pat, segs []string) bool {
+	for len(pat) > 0 {
+		if pat[0] == "**" {
+			// Collapse repeated "**" and try every split point.
+			for len(pat) > 0 && pat[0] == "**" {
+				pat = pat[1:]
+			}
+			if len(pat) == 0 {
+				return true
+			}
+			for i := range segs {
+				if match(pat, segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pat[0], segs[0]); !ok {
+			return false
+		}
+		pat, segs = pat[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
+
+// Expand returns the files matching pattern, a path in the form of the
+// operating system, in lexical order. Without "**" it is filepath.Glob.
+// Otherwise the directory tree below the part of the pattern without
+// metacharacters is walked; hidden directories such as .git are not entered
+// unless the pattern has a segment starting with a dot.
+func Expand(pattern string) ([]string, error) {
+	slashed := filepath.ToSlash(pattern)
+	if !strings.Contains(slashed, "**") {
+		return filepath.Glob(pattern)
+	}
+	if err := Validate(slashed); err != nil {
+		return nil, err
+	}
+
+	segs := strings.Split(slashed, "/")
+	n := 0
+	for n < len(segs) && !strings.ContainsAny(segs[n], "*?[\\") {
+		n++
+	}
+	base := strings.Join(segs[:n], "/")
+	switch {
+	case base == "" && n > 0:
+		base = "/" // pattern is absolute
+	case base == "":
+		base = "."
+	}
+	rest := strings.Join(segs[n:], "/")
+	dots := strings.HasPrefix(rest, ".") || strings.Contains(rest, "/.")
+
+	root := filepath.FromSlash(base)
+	var out []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == root && errors.Is(err, fs.ErrNotExist) {
+				return filepath.SkipAll
+			}
+			return err
+		}
+		if d.IsDir() {
+			if p != root && !dots && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if p == root {
+			return nil // base names a file, not a directory
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		if match(segs[n:], strings.Split(filepath.ToSlash(rel), "/")) {
+			out = append(out, p)
+		}
+		return nil
+	})
+	return out, err
+}
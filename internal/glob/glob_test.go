@@ -0,0 +1,94 @@
+package glob
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*.csv", "a.csv", true},
+		{"*.csv", "dir/a.csv", false},
+		{"**/*_fixed.csv", "a_fixed.csv", true},
+		{"**/*_fixed.csv", "x/y/a_fixed.csv", true},
+		{"**/*_fixed.csv", "x/y/a.csv", false},
+		{"archive/**", "archive/2023/a.csv", true},
+		{"archive/**", "archive", true},
+		{"archive/**", "live/archive.csv", false},
+		{"a/**/b/*.csv", "a/b/x.csv", true},
+		{"a/**/b/*.csv", "a/1/2/b/x.csv", true},
+		{"a/**/**/b", "a/b", true},
+		{"data/[a-c]?.csv", "data/b1.csv", true},
+	}
+	for _, c := range cases {
+		if got := Match(c.pattern, c.name); got != c.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", c.pattern, c.name, got, c.want)
+		}
+	}
+	if Validate("a/**/[") == nil {
+		t.Error("Validate accepted a bad pattern")
+	}
+}
+
+func TestExpand(t *testing.T) {
+	dir := t.TempDir()
+	for _, p := range []string{
+		"projects/a/glossary.csv",
+		"projects/a/b/glossary_de.csv",
+		"projects/notes.csv",
+		"projects/.cache/glossary.csv",
+		"projects/glossary.csv",
+		"other/glossary.csv",
+	} {
+		p = filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rel := func(paths []string) string {
+		var out []string
+		for _, p := range paths {
+			r, _ := filepath.Rel(dir, p)
+			out = append(out, filepath.ToSlash(r))
+		}
+		return strings.Join(out, ",")
+	}
+
+	cases := []struct{ pattern, want string }{
+		{"projects/**/glossary*.csv", "projects/a/b/glossary_de.csv,projects/a/glossary.csv,projects/glossary.csv"},
+		{"projects/**/.cache/*.csv", "projects/.cache/glossary.csv"},
+		{"**/b/*.csv", "projects/a/b/glossary_de.csv"},
+		{"projects/*/glossary.csv", "projects/.cache/glossary.csv,projects/a/glossary.csv"}, // filepath.Glob
+		{"missing/**/*.csv", ""},
+		{"projects/glossary.csv/**", ""},
+	}
+	for _, c := range cases {
+		got, err := Expand(filepath.Join(dir, filepath.FromSlash(c.pattern)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rel(got) != c.want {
+			t.Errorf("Expand(%s) = %s, want %s", c.pattern, rel(got), c.want)
+		}
+	}
+
+	t.Chdir(dir)
+	got, err := Expand("other/**")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.FromSlash("other/glossary.csv"); len(got) != 1 || got[0] != want {
+		t.Errorf("relative: got %v, want [%s]", got, want)
+	}
+	if _, err := Expand("**/[.csv"); err == nil {
+		t.Error("Expand accepted a bad pattern")
+	}
+}
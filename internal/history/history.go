@@ -0,0 +1,126 @@
+// Package history remembers, across runs, how often each check did not pass
+// for each file. It backs --order smart.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+)
+
+const version = 1
+
+// Stat counts runs of one check on one file and how many did not pass.
+type Stat struct {
+	Runs     int `json:"runs"`
+	Failures int `json:"failures"`
+}
+
+// Store is the on-disk history. The zero value is not usable; use Load.
+type Store struct {
+	mu    sync.RWMutex
+	Files map[string]map[string]Stat // abs file path -> check -> stat
+}
+
+type fileFormat struct {
+	Version int                        `json:"version"`
+	Files   map[string]map[string]Stat `json:"files"`
+}
+
+// DefaultPath is the history file in the user cache directory, or in the
+// working directory when there is none.
+func DefaultPath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ".glossaryguard-history.json"
+	}
+	return filepath.Join(dir, "lokalise-glossary-guard", "history.json")
+}
+
+// Load reads the store at path. A missing file yields an empty store.
+func Load(path string) (*Store, error) {
+	s := &Store{Files: map[string]map[string]Stat{}}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ff fileFormat
+	if err := json.Unmarshal(raw, &ff); err != nil {
+		return nil, err
+	}
+	if ff.Version == version && ff.Files != nil {
+		s.Files = ff.Files
+	}
+	return s, nil
+}
+
+// Save writes the store atomically, creating the parent directory.
+func (s *Store) Save(path string) error {
+	s.mu.RLock()
+	raw, err := json.Marshal(fileFormat{Version: version, Files: s.Files})
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return fsutil.WriteFile(path, raw, 0o644)
+}
+
+// Record adds one run's outcomes for file. Anything but PASS is a failure.
+func (s *Store) Record(file string, outcomes []checks.CheckOutcome) {
+	key := fileKey(file)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.Files[key]
+	if m == nil {
+		m = map[string]Stat{}
+		s.Files[key] = m
+	}
+	for _, o := range outcomes {
+		st := m[o.Result.Name]
+		st.Runs++
+		if o.Result.Status != checks.Pass {
+			st.Failures++
+		}
+		m[o.Result.Name] = st
+	}
+}
+
+// FailureRate is the share of runs in which check did not pass on file.
+// Files without history for the check fall back to the rate over all files.
+func (s *Store) FailureRate(file, check string) float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if st, ok := s.Files[fileKey(file)][check]; ok && st.Runs > 0 {
+		return float64(st.Failures) / float64(st.Runs)
+	}
+	var total Stat
+	for _, m := range s.Files {
+		st := m[check]
+		total.Runs += st.Runs
+		total.Failures += st.Failures
+	}
+	if total.Runs == 0 {
+		return 0
+	}
+	return float64(total.Failures) / float64(total.Runs)
+}
+
+func fileKey(p string) string {
+	if abs, err := filepath.Abs(p); err == nil {
+		return abs
+	}
+	return filepath.Clean(p)
+}
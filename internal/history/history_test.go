@@ -0,0 +1,45 @@
+package history
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func outcome(name string, st checks.Status) checks.CheckOutcome {
+	return checks.CheckOutcome{Result: checks.CheckResult{Name: name, Status: st}}
+}
+
+func TestStore_RecordRateAndRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "history.json")
+
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load missing file: %v", err)
+	}
+	s.Record("a.csv", []checks.CheckOutcome{outcome("x", checks.Warn), outcome("y", checks.Pass)})
+	s.Record("a.csv", []checks.CheckOutcome{outcome("x", checks.Pass), outcome("y", checks.Pass)})
+	s.Record("b.csv", []checks.CheckOutcome{outcome("z", checks.Fail)})
+	if err := s.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	s, err = Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := s.FailureRate("a.csv", "x"); got != 0.5 {
+		t.Errorf("a.csv/x: got %v, want 0.5", got)
+	}
+	if got := s.FailureRate("a.csv", "y"); got != 0 {
+		t.Errorf("a.csv/y: got %v, want 0", got)
+	}
+	// no history for z on a.csv: falls back to all files
+	if got := s.FailureRate("a.csv", "z"); got != 1 {
+		t.Errorf("a.csv/z: got %v, want 1", got)
+	}
+	if got := s.FailureRate("c.csv", "unknown"); got != 0 {
+		t.Errorf("unknown: got %v, want 0", got)
+	}
+}
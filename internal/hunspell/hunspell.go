@@ -0,0 +1,429 @@
+// Package hunspell checks words against Hunspell dictionaries (a .aff file
+// with the affix rules and a .dic file with the stems). It understands what
+// most dictionaries need to spell single words: prefixes and suffixes with
+// conditions, cross products, one level of continuation suffixes, flag
+// aliases, NEEDAFFIX and FORBIDDENWORD, in any encoding named by SET.
+// Compounding, replacement tables and suggestions are not supported.
+package hunspell
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// Dictionary holds every word form a dictionary accepts.
+type Dictionary struct {
+	words     map[string]struct{}
+	forbidden map[string]struct{}
+}
+
+// Load reads base+".aff" and base+".dic".
+func Load(base string) (*Dictionary, error) {
+	aff, err := os.ReadFile(base + ".aff")
+	if err != nil {
+		return nil, err
+	}
+	dic, err := os.ReadFile(base + ".dic")
+	if err != nil {
+		return nil, err
+	}
+	d, err := Parse(aff, dic)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", base, err)
+	}
+	return d, nil
+}
+
+// affix is one PFX or SFX rule.
+type affix struct {
+	strip, add string
+	cont       []string // continuation flags
+	cond       *regexp.Regexp
+	cross      bool
+}
+
+type affixFile struct {
+	flagType  string
+	aliases   [][]string
+	prefixes  map[string][]affix
+	suffixes  map[string][]affix
+	needAffix string
+	forbidden string
+}
+
+// Parse builds a dictionary from the contents of a .aff and a .dic file.
+func Parse(aff, dic []byte) (*Dictionary, error) {
+	enc, err := encodingOf(aff)
+	if err != nil {
+		return nil, err
+	}
+	if enc != nil {
+		if aff, err = enc.NewDecoder().Bytes(aff); err != nil {
+			return nil, fmt.Errorf("aff: %w", err)
+		}
+		if dic, err = enc.NewDecoder().Bytes(dic); err != nil {
+			return nil, fmt.Errorf("dic: %w", err)
+		}
+	}
+	af, err := parseAff(aff)
+	if err != nil {
+		return nil, fmt.Errorf("aff: %w", err)
+	}
+
+	d := &Dictionary{words: map[string]struct{}{}, forbidden: map[string]struct{}{}}
+	sc := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(dic, []byte("\ufeff"))))
+	sc.Buffer(nil, 1<<20)
+	first := true
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if first {
+			first = false
+			// The first line is the entry count.
+			if _, err := strconv.Atoi(line); err == nil {
+				continue
+			}
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		word, flags, err := af.entry(line)
+		if err != nil {
+			return nil, fmt.Errorf("dic: %q: %w", line, err)
+		}
+		d.expand(af, word, flags)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("dic: %w", err)
+	}
+	return d, nil
+}
+
+// encodingOf returns the decoder named by SET, or nil for UTF-8.
+func encodingOf(aff []byte) (encoding.Encoding, error) {
+	for _, line := range bytes.Split(aff, []byte("\n")) {
+		f := strings.Fields(string(line))
+		if len(f) < 2 || f[0] != "SET" {
+			continue
+		}
+		name := strings.ToLower(f[1])
+		if name == "utf-8" || name == "utf8" {
+			return nil, nil
+		}
+		if e, err := htmlindex.Get(name); err == nil {
+			return e, nil
+		}
+		if e, err := ianaindex.IANA.Encoding(name); err == nil && e != nil {
+			return e, nil
+		}
+		return nil, fmt.Errorf("aff: unsupported encoding %q", f[1])
+	}
+	return nil, nil
+}
+
+func parseAff(aff []byte) (*affixFile, error) {
+	af := &affixFile{prefixes: map[string][]affix{}, suffixes: map[string][]affix{}}
+	afCount := false
+	lines := strings.Split(strings.TrimPrefix(string(aff), "\ufeff"), "\n")
+	for i := 0; i < len(lines); i++ {
+		f := strings.Fields(lines[i])
+		if len(f) < 2 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		switch f[0] {
+		case "FLAG":
+			af.flagType = f[1]
+		case "NEEDAFFIX", "PSEUDOROOT":
+			af.needAffix = f[1]
+		case "FORBIDDENWORD":
+			af.forbidden = f[1]
+		case "AF":
+			// The first AF line holds the count; aliases are numbered from 1
+			// in the order they appear.
+			if !afCount {
+				afCount = true
+				continue
+			}
+			af.aliases = append(af.aliases, af.flags(f[1]))
+		case "PFX", "SFX":
+			if len(f) < 4 {
+				return nil, fmt.Errorf("line %d: short %s header", i+1, f[0])
+			}
+			n, err := strconv.Atoi(f[3])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s count %q", i+1, f[0], f[3])
+			}
+			flag, cross := f[1], f[2] == "Y"
+			for j := 0; j < n; j++ {
+				i++
+				if i >= len(lines) {
+					return nil, fmt.Errorf("%s %s: expected %d rules", f[0], flag, n)
+				}
+				r := strings.Fields(lines[i])
+				if len(r) < 4 || r[0] != f[0] || r[1] != flag {
+					return nil, fmt.Errorf("line %d: malformed %s rule", i+1, f[0])
+				}
+				a, err := af.rule(r[2], r[3], r[4:], f[0] == "PFX", cross)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", i+1, err)
+				}
+				if f[0] == "PFX" {
+					af.prefixes[flag] = append(af.prefixes[flag], a)
+				} else {
+					af.suffixes[flag] = append(af.suffixes[flag], a)
+				}
+			}
+		}
+	}
+	return af, nil
+}
+
+func (af *affixFile) rule(strip, add string, rest []string, prefix, cross bool) (affix, error) {
+	a := affix{cross: cross}
+	if strip != "0" {
+		a.strip = strip
+	}
+	add, cont, _ := strings.Cut(add, "/")
+	if add != "0" {
+		a.add = add
+	}
+	if cont != "" {
+		a.cont = af.flagsOrAlias(cont)
+	}
+	cond := "."
+	if len(rest) > 0 {
+		cond = rest[0]
+	}
+	expr, err := condition(cond)
+	if err != nil {
+		return affix{}, err
+	}
+	if prefix {
+		expr = "^(?:" + expr + ")"
+	} else {
+		expr = "(?:" + expr + ")$"
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return affix{}, fmt.Errorf("condition %q: %w", cond, err)
+	}
+	a.cond = re
+	return a, nil
+}
+
+// condition turns an affix condition (characters, "." and bracketed
+// classes) into a regular expression.
+func condition(c string) (string, error) {
+	if c == "." {
+		return "", nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(c); {
+		switch c[i] {
+		case '[':
+			end := strings.IndexByte(c[i:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("condition %q: unclosed [", c)
+			}
+			class := c[i+1 : i+end]
+			b.WriteByte('[')
+			if strings.HasPrefix(class, "^") {
+				b.WriteByte('^')
+				class = class[1:]
+			}
+			for _, r := range class {
+				b.WriteString(regexp.QuoteMeta(string(r)))
+			}
+			b.WriteByte(']')
+			i += end + 1
+		case '.':
+			b.WriteByte('.')
+			i++
+		default:
+			r, n := utf8.DecodeRuneInString(c[i:])
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			i += n
+		}
+	}
+	return b.String(), nil
+}
+
+// flags splits a flag string according to FLAG.
+func (af *affixFile) flags(s string) []string {
+	switch af.flagType {
+	case "long":
+		var out []string
+		for len(s) >= 2 {
+			out = append(out, s[:2])
+			s = s[2:]
+		}
+		return out
+	case "num":
+		return strings.Split(s, ",")
+	default:
+		var out []string
+		for _, r := range s {
+			out = append(out, string(r))
+		}
+		return out
+	}
+}
+
+// flagsOrAlias resolves s as an AF alias number when aliases are defined.
+func (af *affixFile) flagsOrAlias(s string) []string {
+	if len(af.aliases) > 0 {
+		if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= len(af.aliases) {
+			return af.aliases[n-1]
+		}
+	}
+	return af.flags(s)
+}
+
+// entry splits a .dic line into the word and its flags. Morphological
+// fields after the first whitespace are ignored; "\/" is a literal slash.
+func (af *affixFile) entry(line string) (string, []string, error) {
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		line = line[:i]
+	}
+	var word strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '/':
+			word.WriteByte('/')
+			i++
+		case line[i] == '/':
+			if word.Len() == 0 {
+				return "", nil, errors.New("empty word")
+			}
+			return word.String(), af.flagsOrAlias(line[i+1:]), nil
+		default:
+			word.WriteByte(line[i])
+		}
+	}
+	return word.String(), nil, nil
+}
+
+// expand adds word and every form its flags produce.
+func (d *Dictionary) expand(af *affixFile, word string, flags []string) {
+	if has(flags, af.forbidden) {
+		d.forbidden[word] = struct{}{}
+		return
+	}
+	if !has(flags, af.needAffix) {
+		d.words[word] = struct{}{}
+	}
+
+	var crossed []string
+	for _, f := range flags {
+		for _, s := range af.suffixes[f] {
+			form, ok := s.applySuffix(word)
+			if !ok {
+				continue
+			}
+			if !has(s.cont, af.needAffix) {
+				d.words[form] = struct{}{}
+			}
+			if s.cross {
+				crossed = append(crossed, form)
+			}
+			for _, cf := range s.cont {
+				for _, s2 := range af.suffixes[cf] {
+					if form2, ok := s2.applySuffix(form); ok {
+						d.words[form2] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+	for _, f := range flags {
+		for _, p := range af.prefixes[f] {
+			if !p.cond.MatchString(word) || !strings.HasPrefix(word, p.strip) {
+				continue
+			}
+			d.words[p.add+word[len(p.strip):]] = struct{}{}
+			if !p.cross {
+				continue
+			}
+			for _, form := range crossed {
+				if strings.HasPrefix(form, p.strip) {
+					d.words[p.add+form[len(p.strip):]] = struct{}{}
+				}
+			}
+		}
+	}
+}
+
+func (a affix) applySuffix(word string) (string, bool) {
+	if !a.cond.MatchString(word) || !strings.HasSuffix(word, a.strip) {
+		return "", false
+	}
+	return word[:len(word)-len(a.strip)] + a.add, true
+}
+
+func has(flags []string, f string) bool {
+	if f == "" {
+		return false
+	}
+	for _, x := range flags {
+		if x == f {
+			return true
+		}
+	}
+	return false
+}
+
+// Check reports whether word is spelled correctly. As in Hunspell, a word
+// may also be capitalized ("Hello" for "hello") or written in capitals
+// ("HELLO" for "hello" or "Hello"), but not lowercased ("paris" for
+// "Paris"). A typographic apostrophe matches a straight one.
+func (d *Dictionary) Check(word string) bool {
+	if word == "" {
+		return true
+	}
+	if d.check(word) {
+		return true
+	}
+	if straight := strings.ReplaceAll(word, "’", "'"); straight != word {
+		return d.check(straight)
+	}
+	return false
+}
+
+func (d *Dictionary) check(word string) bool {
+	if _, bad := d.forbidden[word]; bad {
+		return false
+	}
+	if d.has(word) {
+		return true
+	}
+	lower := strings.ToLower(word)
+	first, n := utf8.DecodeRuneInString(word)
+	switch {
+	case word == strings.ToUpper(word):
+		_, m := utf8.DecodeRuneInString(lower)
+		title := string(first) + lower[m:]
+		return d.has(lower) || d.has(title)
+	case unicode.IsUpper(first) && word[n:] == strings.ToLower(word[n:]):
+		return d.has(lower)
+	}
+	return false
+}
+
+func (d *Dictionary) has(w string) bool {
+	if _, bad := d.forbidden[w]; bad {
+		return false
+	}
+	_, ok := d.words[w]
+	return ok
+}
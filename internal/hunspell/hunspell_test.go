@@ -0,0 +1,104 @@
+package hunspell
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testAff = `SET UTF-8
+NEEDAFFIX X
+FORBIDDENWORD F
+
+PFX U Y 1
+PFX U 0 un .
+
+SFX S Y 2
+SFX S y ies [^aeiou]y
+SFX S 0 s [^y]
+
+SFX G N 1
+SFX G 0 ing/S .
+`
+
+const testDic = `7
+city/S
+walk/SUG
+Paris
+colour/F
+foo/XS
+don't
+path\/name
+`
+
+func TestCheck(t *testing.T) {
+	d, err := Parse([]byte(testAff), []byte(testDic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]bool{
+		"city":      true,
+		"cities":    true,
+		"citys":     false,
+		"walks":     true,
+		"unwalk":    true,
+		"unwalks":   true, // cross product
+		"walking":   true,
+		"walkings":  true, // continuation suffix
+		"unwalking": false,
+		"City":      true,
+		"CITIES":    true,
+		"cIty":      false,
+		"Paris":     true,
+		"PARIS":     true,
+		"paris":     false,
+		"colour":    false,
+		"foo":       false,
+		"foos":      true,
+		"don’t":     true,
+		"path/name": true,
+		"":          true,
+	}
+	for w, want := range cases {
+		if got := d.Check(w); got != want {
+			t.Errorf("Check(%q) = %v, want %v", w, got, want)
+		}
+	}
+}
+
+func TestLongFlagsAndAliases(t *testing.T) {
+	aff := "FLAG long\nAF 1\nAF AaBb\nPFX Aa N 1\nPFX Aa 0 re .\nSFX Bb N 1\nSFX Bb 0 s .\n"
+	d, err := Parse([]byte(aff), []byte("1\nrun/1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range []string{"run", "rerun", "runs"} {
+		if !d.Check(w) {
+			t.Errorf("Check(%q) = false", w)
+		}
+	}
+	if d.Check("reruns") {
+		t.Error("reruns accepted without cross product")
+	}
+}
+
+func TestLoadLatin1(t *testing.T) {
+	base := filepath.Join(t.TempDir(), "fr")
+	if err := os.WriteFile(base+".aff", []byte("SET ISO8859-1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(base+".dic", []byte("1\ncaf\xe9\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	d, err := Load(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Check("café") {
+		t.Error("café not found in a Latin-1 dictionary")
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing dictionary")
+	}
+}
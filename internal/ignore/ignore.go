@@ -0,0 +1,166 @@
+// Package ignore reads .glossaryguardignore files: gitignore-style lists of
+// glossaries to leave out when files are found through globs or
+// directories. An ignore file applies to its directory and everything
+// below it; rules of deeper files and later lines win.
+package ignore
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/glob"
+)
+
+// File is the name of an ignore file.
+const File = ".glossaryguardignore"
+
+// rule is one line of an ignore file.
+type rule struct {
+	pattern  string
+	negate   bool // "!pattern" includes again
+	dirOnly  bool // "pattern/" matches directories only
+	anchored bool // contains a "/": matched from the ignore file's directory
+}
+
+// Matcher decides whether paths are ignored, reading the ignore files of
+// root and of the directories between it and each path. Safe for
+// concurrent use.
+type Matcher struct {
+	root string
+
+	mu    sync.Mutex
+	rules map[string][]rule // by directory
+}
+
+// New returns a matcher for paths under root, usually the working directory.
+func New(root string) (*Matcher, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	return &Matcher{root: abs, rules: map[string][]rule{}}, nil
+}
+
+// Ignored reports whether p is left out. Paths outside root only see the
+// ignore file of their own directory.
+func (m *Matcher) Ignored(p string) (bool, error) {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return false, err
+	}
+	dirs := m.dirsFor(filepath.Dir(abs))
+	ignored := false
+	for _, dir := range dirs {
+		rules, err := m.load(dir)
+		if err != nil {
+			return false, err
+		}
+		rel, err := filepath.Rel(dir, abs)
+		if err != nil {
+			continue
+		}
+		segs := strings.Split(filepath.ToSlash(rel), "/")
+		for _, r := range rules {
+			if r.matches(segs) {
+				ignored = !r.negate
+			}
+		}
+	}
+	return ignored, nil
+}
+
+// dirsFor lists the directories whose ignore files apply to files in dir,
+// outermost first.
+func (m *Matcher) dirsFor(dir string) []string {
+	rel, err := filepath.Rel(m.root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return []string{dir}
+	}
+	out := []string{m.root}
+	if rel == "." {
+		return out
+	}
+	cur := m.root
+	for _, seg := range strings.Split(rel, string(filepath.Separator)) {
+		cur = filepath.Join(cur, seg)
+		out = append(out, cur)
+	}
+	return out
+}
+
+func (m *Matcher) load(dir string) ([]rule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if rules, ok := m.rules[dir]; ok {
+		return rules, nil
+	}
+	path := filepath.Join(dir, File)
+	raw, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	rules, err := parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	m.rules[dir] = rules
+	return rules, nil
+}
+
+// parse reads gitignore syntax: blank lines and "#" comments are skipped,
+// "!" negates, a trailing "/" matches directories only and a leading "\"
+// escapes "#" or "!".
+func parse(raw []byte) ([]rule, error) {
+	var out []rule
+	sc := bufio.NewScanner(bytes.NewReader(raw))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var r rule
+		if strings.HasPrefix(line, "!") {
+			r.negate = true
+			line = line[1:]
+		}
+		line = strings.TrimPrefix(line, `\`)
+		if strings.HasSuffix(line, "/") {
+			r.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		r.anchored = strings.Contains(line, "/")
+		r.pattern = strings.TrimPrefix(line, "/")
+		if r.pattern == "" {
+			continue
+		}
+		if err := glob.Validate(r.pattern); err != nil {
+			return nil, fmt.Errorf("line %d: %q: %w", n, line, err)
+		}
+		out = append(out, r)
+	}
+	return out, sc.Err()
+}
+
+// matches reports whether r matches the file at segs (relative to the
+// ignore file) or one of the directories it is in.
+func (r rule) matches(segs []string) bool {
+	for k := 1; k <= len(segs); k++ {
+		isDir := k < len(segs)
+		if r.dirOnly && !isDir {
+			continue
+		}
+		name := segs[k-1]
+		if r.anchored {
+			name = strings.Join(segs[:k], "/")
+		}
+		if glob.Match(r.pattern, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package ignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func write(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIgnored(t *testing.T) {
+	root := t.TempDir()
+	write(t, filepath.Join(root, File), `# fixtures and archives
+fixtures/
+/templates/*.csv
+*_old.csv
+!keep_old.csv
+\#hash.csv
+`)
+	write(t, filepath.Join(root, "data", File), "draft.csv\n!/fixtures/\n")
+
+	m, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		path string
+		want bool
+	}{
+		{"g.csv", false},
+		{"fixtures/a.csv", true},
+		{"sub/fixtures/deep/a.csv", true},
+		{"fixtures.csv", false},
+		{"templates/t.csv", true},
+		{"sub/templates/t.csv", false},
+		{"a_old.csv", true},
+		{"sub/b_old.csv", true},
+		{"keep_old.csv", false},
+		{"#hash.csv", true},
+		{"data/draft.csv", true},
+		{"draft.csv", false},
+		{"data/fixtures/a.csv", false},
+	}
+	for _, c := range cases {
+		got, err := m.Ignored(filepath.Join(root, filepath.FromSlash(c.path)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("Ignored(%s) = %v, want %v", c.path, got, c.want)
+		}
+	}
+}
+
+func TestIgnoredOutsideRoot(t *testing.T) {
+	root, other := t.TempDir(), t.TempDir()
+	write(t, filepath.Join(root, File), "*.csv\n")
+	write(t, filepath.Join(other, File), "skip.csv\n")
+
+	m, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]bool{"g.csv": false, "skip.csv": true} {
+		got, err := m.Ignored(filepath.Join(other, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Ignored(%s) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestBadPattern(t *testing.T) {
+	root := t.TempDir()
+	write(t, filepath.Join(root, File), "ok.csv\n[bad\n")
+	m, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Ignored(filepath.Join(root, "g.csv")); err == nil {
+		t.Error("a bad pattern should be reported")
+	}
+}
@@ -0,0 +1,118 @@
+// Package ledger keeps a JSONL history of validate runs (one line per run)
+// and derives whether glossary health improves or regresses over time.
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultFile is the ledger the trend command reads when none is given.
+const DefaultFile = "glossary-ledger.jsonl"
+
+// Entry summarizes one validate run. Counts are per file, like the totals
+// line printed by validate.
+type Entry struct {
+	Time       time.Time `json:"time"`
+	Commit     string    `json:"commit,omitempty"`
+	Files      int       `json:"files"`
+	Pass       int       `json:"pass"`
+	Warn       int       `json:"warn"`
+	Fail       int       `json:"fail"`
+	Error      int       `json:"error"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+// Failing is the number of files that failed or could not be validated.
+func (e Entry) Failing() int { return e.Fail + e.Error }
+
+// Append writes e as one line at the end of the ledger, creating it if needed.
+func Append(path string, e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Read returns all entries in file order. Blank lines are ignored.
+func Read(path string) ([]Entry, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var out []Entry
+	sc := bufio.NewScanner(bytes.NewReader(raw))
+	sc.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+		out = append(out, e)
+	}
+	return out, sc.Err()
+}
+
+// CurrentCommit returns the commit being validated: the CI-provided SHA if
+// any, else git HEAD of the working directory, else "".
+func CurrentCommit() string {
+	for _, env := range []string{"GITHUB_SHA", "CI_COMMIT_SHA", "BUILD_SOURCEVERSION", "GIT_COMMIT"} {
+		if v := strings.TrimSpace(os.Getenv(env)); v != "" {
+			return v
+		}
+	}
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// Direction of glossary health between two runs.
+type Direction string
+
+const (
+	Improving  Direction = "improving"
+	Regressing Direction = "regressing"
+	Stable     Direction = "stable"
+)
+
+// Trend compares the first and last entries: fewer failing files is an
+// improvement; with equal failures, fewer warnings is.
+func Trend(entries []Entry) Direction {
+	if len(entries) < 2 {
+		return Stable
+	}
+	first, last := entries[0], entries[len(entries)-1]
+	switch {
+	case last.Failing() < first.Failing():
+		return Improving
+	case last.Failing() > first.Failing():
+		return Regressing
+	case last.Warn < first.Warn:
+		return Improving
+	case last.Warn > first.Warn:
+		return Regressing
+	default:
+		return Stable
+	}
+}
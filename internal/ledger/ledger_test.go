@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAppendAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.jsonl")
+	ts := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+
+	for i, e := range []Entry{
+		{Time: ts, Commit: "abc", Files: 2, Pass: 1, Fail: 1},
+		{Time: ts.Add(time.Hour), Files: 2, Pass: 2},
+	} {
+		if err := Append(path, e); err != nil {
+			t.Fatalf("append %d: %v", i, err)
+		}
+	}
+
+	got, err := Read(path)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if len(got) != 2 || got[0].Commit != "abc" || !got[0].Time.Equal(ts) || got[1].Pass != 2 {
+		t.Fatalf("unexpected entries: %+v", got)
+	}
+}
+
+func TestTrend(t *testing.T) {
+	cases := []struct {
+		name    string
+		entries []Entry
+		want    Direction
+	}{
+		{"single run", []Entry{{Fail: 3}}, Stable},
+		{"fewer failures", []Entry{{Fail: 3}, {Fail: 5}, {Fail: 1}}, Improving},
+		{"more errors", []Entry{{Fail: 1}, {Fail: 1, Error: 1}}, Regressing},
+		{"fewer warnings", []Entry{{Warn: 4}, {Warn: 2}}, Improving},
+		{"more warnings", []Entry{{Warn: 1}, {Warn: 2}}, Regressing},
+		{"unchanged", []Entry{{Fail: 1, Warn: 1}, {Fail: 1, Warn: 1}}, Stable},
+	}
+	for _, tc := range cases {
+		if got := Trend(tc.entries); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
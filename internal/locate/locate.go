@@ -0,0 +1,198 @@
+// Package locate registers locators for the core checks, which report
+// problems only as one free-text message. Each locator re-derives the
+// individual findings the check summarizes, following the same rules as
+// the check, so reports can list every offending row.
+package locate
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+var flagCols = []string{"casesensitive", "translatable", "forbidden"}
+
+func init() {
+	findings.RegisterLocator("ensure-no-empty-lines", emptyLines)
+	findings.RegisterLocator("no-spaces-in-header", headerCells(func(h string) string {
+		if h != strings.TrimSpace(h) {
+			return "header cell " + strconv.Quote(h) + " has leading or trailing spaces"
+		}
+		return ""
+	}))
+	findings.RegisterLocator("ensure-lowercase-header", headerCells(func(h string) string {
+		n := csvutil.NormalizeHeader(h)
+		if _, known := checks.KnownHeaders[n]; known && strings.TrimSpace(h) != n {
+			return "header cell " + strconv.Quote(h) + " should be " + strconv.Quote(n)
+		}
+		return ""
+	}))
+	findings.RegisterLocator("warn-duplicate-header-cells", duplicateHeaderCells)
+	findings.RegisterLocator("warn-orphan-locale-descriptions", orphanDescriptions)
+	findings.RegisterLocator("no-empty-term-values", rowsWhere("term", func(v string) string {
+		if strings.TrimSpace(v) == "" {
+			return "empty term"
+		}
+		return ""
+	}))
+	findings.RegisterLocator("warn-duplicate-term-values", duplicateTerms)
+	findings.RegisterLocator("no-invalid-flags", invalidFlags)
+}
+
+// emptyLines reports whitespace-only lines; the newline ending the last
+// line does not start a new one.
+func emptyLines(a checks.Artifact) []findings.Finding {
+	starts := csvutil.LineStarts(a.Data)
+	var out []findings.Finding
+	for i, start := range starts {
+		end := int64(len(a.Data))
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		if len(bytes.TrimSpace(a.Data[start:end])) == 0 {
+			out = append(out, findings.At(i+1, "", start, "empty line"))
+		}
+	}
+	return out
+}
+
+func parse(a checks.Artifact) *csvutil.Table {
+	tbl, err := csvutil.Parse(a.Data)
+	if err != nil {
+		return nil
+	}
+	return tbl
+}
+
+func headerAt(tbl *csvutil.Table, col, msg string) findings.Finding {
+	return findings.At(tbl.HeaderLine, col, tbl.HeaderOffset, msg)
+}
+
+// headerCells reports each header cell for which problem returns a message.
+func headerCells(problem func(h string) string) findings.Locator {
+	return func(a checks.Artifact) []findings.Finding {
+		tbl := parse(a)
+		if tbl == nil {
+			return nil
+		}
+		var out []findings.Finding
+		for _, h := range tbl.Header {
+			if msg := problem(h); msg != "" {
+				out = append(out, headerAt(tbl, h, msg))
+			}
+		}
+		return out
+	}
+}
+
+func duplicateHeaderCells(a checks.Artifact) []findings.Finding {
+	tbl := parse(a)
+	if tbl == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var out []findings.Finding
+	for _, h := range tbl.Header {
+		n := csvutil.NormalizeHeader(h)
+		if n == "" {
+			continue
+		}
+		if seen[n] {
+			out = append(out, headerAt(tbl, h, "duplicate header column "+strconv.Quote(n)))
+		}
+		seen[n] = true
+	}
+	return out
+}
+
+func orphanDescriptions(a checks.Artifact) []findings.Finding {
+	tbl := parse(a)
+	if tbl == nil {
+		return nil
+	}
+	var out []findings.Finding
+	for _, h := range tbl.Header {
+		n := csvutil.NormalizeHeader(h)
+		lang, ok := strings.CutSuffix(n, "_description")
+		if !ok || lang == "" {
+			continue
+		}
+		if tbl.Col(lang) < 0 {
+			out = append(out, headerAt(tbl, h, "no "+strconv.Quote(lang)+" column for "+strconv.Quote(n)))
+		}
+	}
+	return out
+}
+
+// rowsWhere reports each row whose col cell problem returns a message for.
+func rowsWhere(col string, problem func(v string) string) findings.Locator {
+	return func(a checks.Artifact) []findings.Finding {
+		tbl := parse(a)
+		if tbl == nil {
+			return nil
+		}
+		i := tbl.Col(col)
+		if i < 0 {
+			return nil
+		}
+		var out []findings.Finding
+		for _, r := range tbl.Rows {
+			if msg := problem(r.Get(i)); msg != "" {
+				out = append(out, findings.At(r.Line, strings.TrimSpace(tbl.Header[i]), r.Offset, msg))
+			}
+		}
+		return out
+	}
+}
+
+// duplicateTerms reports every repetition of a term, not its first row.
+func duplicateTerms(a checks.Artifact) []findings.Finding {
+	tbl := parse(a)
+	if tbl == nil {
+		return nil
+	}
+	i := tbl.Col("term")
+	if i < 0 {
+		return nil
+	}
+	first := map[string]int{}
+	var out []findings.Finding
+	for _, r := range tbl.Rows {
+		v := strings.TrimSpace(r.Get(i))
+		if v == "" {
+			continue
+		}
+		if line, ok := first[v]; ok {
+			msg := "duplicate term " + strconv.Quote(v) + " (first on row " + strconv.Itoa(line) + ")"
+			out = append(out, findings.At(r.Line, strings.TrimSpace(tbl.Header[i]), r.Offset, msg))
+			continue
+		}
+		first[v] = r.Line
+	}
+	return out
+}
+
+func invalidFlags(a checks.Artifact) []findings.Finding {
+	tbl := parse(a)
+	if tbl == nil {
+		return nil
+	}
+	var out []findings.Finding
+	for _, r := range tbl.Rows {
+		for _, name := range flagCols {
+			i := tbl.Col(name)
+			if i < 0 {
+				continue
+			}
+			if v := strings.TrimSpace(r.Get(i)); v != "yes" && v != "no" {
+				out = append(out, findings.At(r.Line, name, r.Offset, "invalid flag value "+strconv.Quote(v)+" (want yes/no)"))
+			}
+		}
+	}
+	return out
+}
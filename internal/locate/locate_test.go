@@ -0,0 +1,66 @@
+package locate
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+func locate(t *testing.T, check, data string) []findings.Finding {
+	t.Helper()
+	l, ok := findings.LocatorFor(check)
+	if !ok {
+		t.Fatalf("no locator for %s", check)
+	}
+	return l(checks.Artifact{Data: []byte(data)})
+}
+
+func TestLocators(t *testing.T) {
+	data := "term;description; Forbidden;casesensitive;fr_description;DESCRIPTION\n" + // line 1 (offset 0)
+		"API;;no;maybe;;\n" + // line 2 (offset 69)
+		";x;yes;yes;;\n" + // line 3
+		"  \n" + // line 4
+		"API;;yes;;;\n" // line 5
+
+	cases := []struct {
+		check string
+		want  []findings.Finding
+	}{
+		{"no-spaces-in-header", []findings.Finding{
+			findings.At(1, " Forbidden", 0, `header cell " Forbidden" has leading or trailing spaces`),
+		}},
+		{"ensure-lowercase-header", []findings.Finding{
+			findings.At(1, " Forbidden", 0, `header cell " Forbidden" should be "forbidden"`),
+			findings.At(1, "DESCRIPTION", 0, `header cell "DESCRIPTION" should be "description"`),
+		}},
+		{"warn-duplicate-header-cells", []findings.Finding{
+			findings.At(1, "DESCRIPTION", 0, `duplicate header column "description"`),
+		}},
+		{"warn-orphan-locale-descriptions", []findings.Finding{
+			findings.At(1, "fr_description", 0, `no "fr" column for "fr_description"`),
+		}},
+		{"no-empty-term-values", []findings.Finding{findings.At(3, "term", 85, "empty term")}},
+		{"warn-duplicate-term-values", []findings.Finding{
+			findings.At(5, "term", 101, `duplicate term "API" (first on row 2)`),
+		}},
+		{"no-invalid-flags", []findings.Finding{
+			findings.At(2, "casesensitive", 69, `invalid flag value "maybe" (want yes/no)`),
+			findings.At(5, "casesensitive", 101, `invalid flag value "" (want yes/no)`),
+		}},
+		{"ensure-no-empty-lines", []findings.Finding{findings.At(4, "", 98, "empty line")}},
+	}
+	for _, tc := range cases {
+		got := locate(t, tc.check, data)
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: got %+v, want %+v", tc.check, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%s[%d]: got %+v, want %+v", tc.check, i, got[i], tc.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package lokalise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// TokenEnv holds the API token when it is not passed explicitly.
+const TokenEnv = "LOKALISE_API_TOKEN"
+
+// DefaultBaseURL is the Lokalise API v2 root.
+const DefaultBaseURL = "https://api.lokalise.com/api2"
+
+// MaxTermsPerRequest caps the terms sent in one create/update call.
+const MaxTermsPerRequest = 1000
+
+// Client is a minimal Lokalise API client for glossary uploads.
+type Client struct {
+	BaseURL string
+	Token   string
+	HTTP    *http.Client
+}
+
+// NewClient returns a client for the public API.
+func NewClient(token string) *Client {
+	return &Client{
+		BaseURL: DefaultBaseURL,
+		Token:   token,
+		HTTP:    &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// APIError is a non-2xx response.
+type APIError struct {
+	Status  int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("lokalise api: %d %s", e.Status, e.Message)
+}
+
+// Language is a project language.
+type Language struct {
+	ID  int    `json:"lang_id"`
+	ISO string `json:"lang_iso"`
+}
+
+// Languages lists all languages of a project.
+func (c *Client) Languages(ctx context.Context, project string) ([]Language, error) {
+	return c.languages(ctx, "/projects/"+url.PathEscape(project)+"/languages")
+}
+
+// SystemLanguages lists every language Lokalise supports.
+func (c *Client) SystemLanguages(ctx context.Context) ([]Language, error) {
+	return c.languages(ctx, "/system/languages")
+}
+
+func (c *Client) languages(ctx context.Context, path string) ([]Language, error) {
+	var all []Language
+	for page := 1; ; page++ {
+		var out struct {
+			Languages []Language `json:"languages"`
+		}
+		q := url.Values{"limit": {"500"}, "page": {strconv.Itoa(page)}}
+		hdr, err := c.do(ctx, http.MethodGet, path, q, nil, &out)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, out.Languages...)
+		pages, _ := strconv.Atoi(hdr.Get("X-Pagination-Page-Count"))
+		if page >= pages || len(out.Languages) == 0 {
+			return all, nil
+		}
+	}
+}
+
+// GlossaryTerms lists all glossary terms of a project.
+func (c *Client) GlossaryTerms(ctx context.Context, project string) ([]Term, error) {
+	var all []Term
+	cursor := ""
+	for {
+		var out struct {
+			Data []Term `json:"data"`
+			Meta struct {
+				Cursor string `json:"cursor"`
+			} `json:"meta"`
+		}
+		q := url.Values{"limit": {strconv.Itoa(MaxTermsPerRequest)}}
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+		if _, err := c.do(ctx, http.MethodGet, glossaryPath(project), q, nil, &out); err != nil {
+			return nil, err
+		}
+		all = append(all, out.Data...)
+		if out.Meta.Cursor == "" || len(out.Data) == 0 {
+			return all, nil
+		}
+		cursor = out.Meta.Cursor
+	}
+}
+
+// CreateTerms creates terms in one call.
+func (c *Client) CreateTerms(ctx context.Context, project string, terms []Term) error {
+	_, err := c.do(ctx, http.MethodPost, glossaryPath(project), nil, map[string][]Term{"terms": terms}, nil)
+	return err
+}
+
+// UpdateTerms updates existing terms (matched by ID) in one call.
+func (c *Client) UpdateTerms(ctx context.Context, project string, terms []Term) error {
+	_, err := c.do(ctx, http.MethodPut, glossaryPath(project), nil, map[string][]Term{"terms": terms}, nil)
+	return err
+}
+
+func glossaryPath(project string) string {
+	return "/projects/" + url.PathEscape(project) + "/glossary-terms"
+}
+
+func (c *Client) do(ctx context.Context, method, path string, q url.Values, body, out any) (http.Header, error) {
+	u := c.BaseURL + path
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	var rd io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		rd = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, rd)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Api-Token", c.Token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		var e struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		msg := resp.Status
+		if json.Unmarshal(raw, &e) == nil && e.Error.Message != "" {
+			msg = e.Error.Message
+		}
+		return resp.Header, &APIError{Status: resp.StatusCode, Message: msg}
+	}
+	if out != nil && len(raw) > 0 {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return resp.Header, fmt.Errorf("decode %s %s: %w", method, path, err)
+		}
+	}
+	return resp.Header, nil
+}
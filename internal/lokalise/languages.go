@@ -0,0 +1,66 @@
+package lokalise
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+)
+
+// languagesTTL is how long the list of supported languages is reused; it
+// changes rarely.
+const languagesTTL = 24 * time.Hour
+
+type languagesCache struct {
+	Fetched time.Time `json:"fetched"`
+	Codes   []string  `json:"codes"`
+}
+
+// DefaultLanguagesCache is where SupportedCodes keeps its copy, in the user
+// cache directory or the working directory when there is none.
+func DefaultLanguagesCache() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ".glossaryguard-languages.json"
+	}
+	return filepath.Join(dir, "lokalise-glossary-guard", "languages.json")
+}
+
+// SupportedCodes returns the ISO codes of every language Lokalise supports,
+// normalized by NormalizeCode. The list is cached at path for a day; a
+// stale copy is used when the API cannot be reached.
+func SupportedCodes(ctx context.Context, c *Client, path string) (map[string]struct{}, error) {
+	var cached languagesCache
+	raw, err := os.ReadFile(path)
+	haveCache := err == nil && json.Unmarshal(raw, &cached) == nil && len(cached.Codes) > 0
+	if !haveCache || time.Since(cached.Fetched) >= languagesTTL {
+		langs, err := c.SystemLanguages(ctx)
+		switch {
+		case err == nil:
+			cached = languagesCache{Fetched: time.Now().UTC()}
+			for _, l := range langs {
+				cached.Codes = append(cached.Codes, l.ISO)
+			}
+			if raw, err := json.Marshal(cached); err == nil {
+				_ = fsutil.WriteFile(path, raw, 0o644) // best effort: the list is still usable
+			}
+		case !haveCache:
+			return nil, err
+		}
+	}
+	set := make(map[string]struct{}, len(cached.Codes))
+	for _, c := range cached.Codes {
+		set[NormalizeCode(c)] = struct{}{}
+	}
+	return set, nil
+}
+
+// NormalizeCode folds the spellings Lokalise treats as one language code
+// ("pt-BR", "pt_br") to a single form ("pt_br").
+func NormalizeCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", "_"))
+}
@@ -0,0 +1,43 @@
+package lokalise
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestSupportedCodesCaches(t *testing.T) {
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.URL.Path != "/system/languages" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"languages":[{"lang_id":640,"lang_iso":"en"},{"lang_id":667,"lang_iso":"pt_BR"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("tok")
+	c.BaseURL = srv.URL
+	path := filepath.Join(t.TempDir(), "languages.json")
+	for range 2 {
+		codes, err := SupportedCodes(context.Background(), c, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := codes[NormalizeCode("pt-br")]; !ok || len(codes) != 2 {
+			t.Fatalf("codes = %v", codes)
+		}
+	}
+	if hits != 1 {
+		t.Fatalf("API called %d times, want 1", hits)
+	}
+
+	srv.Close()
+	c.BaseURL = "http://127.0.0.1:1"
+	if _, err := SupportedCodes(context.Background(), c, filepath.Join(t.TempDir(), "none.json")); err == nil {
+		t.Fatal("want error without API and cache")
+	}
+}
@@ -0,0 +1,49 @@
+// Package lokalise holds knowledge about the Lokalise platform contract:
+// glossary limits, the term model and a minimal API client for uploads.
+package lokalise
+
+// LimitsVersion identifies the limits table below. Bump it whenever a value
+// changes so reports can tell which contract a file was checked against.
+const LimitsVersion = "2025-11"
+
+// Limits are hard constraints a glossary must satisfy to be imported.
+// Lengths are counted in Unicode code points.
+type Limits struct {
+	TermMaxLen        int `yaml:"term_max_len" json:"term_max_len"`
+	DescriptionMaxLen int `yaml:"description_max_len" json:"description_max_len"`
+	TranslationMaxLen int `yaml:"translation_max_len" json:"translation_max_len"`
+	MaxTagsPerTerm    int `yaml:"max_tags_per_term" json:"max_tags_per_term"`
+	TagMaxLen         int `yaml:"tag_max_len" json:"tag_max_len"`
+	MaxTerms          int `yaml:"max_terms" json:"max_terms"`
+}
+
+// DefaultLimits is the single table of platform limits used by all checks.
+var DefaultLimits = Limits{
+	TermMaxLen:        255,
+	DescriptionMaxLen: 2000,
+	TranslationMaxLen: 255,
+	MaxTagsPerTerm:    20,
+	TagMaxLen:         100,
+	MaxTerms:          20000,
+}
+
+// FlagValues is the allowed domain of casesensitive/translatable/forbidden.
+var FlagValues = []string{"yes", "no"}
+
+// Merge returns l with every non-zero field of override applied.
+func (l Limits) Merge(override Limits) Limits {
+	pick := func(base, o int) int {
+		if o > 0 {
+			return o
+		}
+		return base
+	}
+	return Limits{
+		TermMaxLen:        pick(l.TermMaxLen, override.TermMaxLen),
+		DescriptionMaxLen: pick(l.DescriptionMaxLen, override.DescriptionMaxLen),
+		TranslationMaxLen: pick(l.TranslationMaxLen, override.TranslationMaxLen),
+		MaxTagsPerTerm:    pick(l.MaxTagsPerTerm, override.MaxTagsPerTerm),
+		TagMaxLen:         pick(l.TagMaxLen, override.TagMaxLen),
+		MaxTerms:          pick(l.MaxTerms, override.MaxTerms),
+	}
+}
@@ -0,0 +1,284 @@
+package lokalise
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Plan is everything an upload will send, computed without the API.
+type Plan struct {
+	Project    string
+	Terms      []Term
+	Locales    []LocaleMapping
+	Duplicates []Duplicate
+	Policy     string
+	BatchSize  int
+}
+
+// Batches splits Terms into request-sized chunks.
+func (p Plan) Batches() [][]Term {
+	size := p.BatchSize
+	if size <= 0 || size > MaxTermsPerRequest {
+		size = MaxTermsPerRequest
+	}
+	var out [][]Term
+	for i := 0; i < len(p.Terms); i += size {
+		out = append(out, p.Terms[i:min(i+size, len(p.Terms))])
+	}
+	return out
+}
+
+// Codes returns the distinct Lokalise language codes used by the terms.
+func (p Plan) Codes() []string {
+	var codes []string
+	for _, l := range p.Locales {
+		if !slices.Contains(codes, l.Lokalise) {
+			codes = append(codes, l.Lokalise)
+		}
+	}
+	return codes
+}
+
+// WriteDryRun prints the mapping, the duplicate handling and every API call
+// the upload would make.
+func (p Plan) WriteDryRun(w io.Writer) error {
+	fmt.Fprintf(w, "Project: %s\n", p.Project)
+	fmt.Fprintf(w, "Terms to send: %d\n", len(p.Terms))
+
+	fmt.Fprintln(w, "\nLocale mapping (CSV column -> Lokalise code):")
+	if len(p.Locales) == 0 {
+		fmt.Fprintln(w, "  (no language columns)")
+	}
+	for _, l := range p.Locales {
+		fmt.Fprintf(w, "  %s -> %s\n", l.Column, l.Lokalise)
+	}
+
+	fmt.Fprintf(w, "\nDuplicates (policy %q): %d\n", p.Policy, len(p.Duplicates))
+	for _, d := range p.Duplicates {
+		fmt.Fprintf(w, "  %q on lines %s\n", d.Term, joinInts(d.Lines))
+	}
+
+	batches := p.Batches()
+	fmt.Fprintln(w, "\nAPI calls:")
+	fmt.Fprintf(w, "  1. GET  /projects/%s/languages  (resolve %d code(s): %s)\n",
+		p.Project, len(p.Codes()), strings.Join(p.Codes(), ", "))
+	fmt.Fprintf(w, "  2. GET  /projects/%s/glossary-terms  (list existing terms, paginated)\n", p.Project)
+	for i, b := range batches {
+		raw, err := json.Marshal(map[string][]Term{"terms": b})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  %d. POST|PUT /projects/%s/glossary-terms  (batch %d/%d: %d term(s), ~%s)\n",
+			i+3, p.Project, i+1, len(batches), len(b), humanBytes(len(raw)))
+	}
+	fmt.Fprintln(w, "\nTerms that already exist in the project are sent with PUT, new ones with POST;")
+	fmt.Fprintln(w, "the split is only known once step 2 runs. Nothing was sent (dry run).")
+	return nil
+}
+
+// Result counts what an upload changed.
+type Result struct {
+	Created int
+	Updated int
+	// Skipped counts terms in chunks a resumed upload had already sent.
+	Skipped int
+}
+
+// UploadOptions control chunking and recovery of an upload.
+type UploadOptions struct {
+	// Retries is how many times a failed API call of a chunk is repeated
+	// when the failure is transient (network error, 429 or 5xx). A create
+	// is only repeated for the terms that a fresh listing does not show.
+	Retries int
+	// Backoff is the wait before the first retry; it doubles each time.
+	Backoff time.Duration
+	// Done marks chunks that an earlier run already uploaded; they are
+	// skipped.
+	Done map[int]bool
+	// OnChunk, if set, is called after each chunk is uploaded or skipped.
+	// Returning an error stops the upload.
+	OnChunk func(chunk, total int, res Result) error
+}
+
+// Upload resolves language ids, splits terms into new and existing ones and
+// sends them chunk by chunk. Existing terms are listed before the first
+// chunk, so terms created by an interrupted run are updated, not created
+// twice, when the upload is resumed.
+func Upload(ctx context.Context, c *Client, p Plan, opts UploadOptions) (Result, error) {
+	var res Result
+
+	langs, err := c.Languages(ctx, p.Project)
+	if err != nil {
+		return res, fmt.Errorf("list languages: %w", err)
+	}
+	ids := make(map[string]int, len(langs))
+	for _, l := range langs {
+		ids[l.ISO] = l.ID
+	}
+	var missing []string
+	for _, code := range p.Codes() {
+		if _, ok := ids[code]; !ok {
+			missing = append(missing, code)
+		}
+	}
+	if len(missing) > 0 {
+		return res, fmt.Errorf("languages not in project %s: %s", p.Project, strings.Join(missing, ", "))
+	}
+
+	existing, err := c.GlossaryTerms(ctx, p.Project)
+	if err != nil {
+		return res, fmt.Errorf("list glossary terms: %w", err)
+	}
+	existingIDs := make(map[string]int64, len(existing))
+	for _, t := range existing {
+		existingIDs[t.Term] = t.ID
+	}
+
+	batches := p.Batches()
+	for i, batch := range batches {
+		if opts.Done[i] {
+			res.Skipped += len(batch)
+		} else {
+			var create, update []Term
+			for _, t := range batch {
+				for j := range t.Translations {
+					t.Translations[j].LangID = ids[t.Translations[j].LangISO]
+				}
+				if id, ok := existingIDs[t.Term]; ok {
+					t.ID = id
+					update = append(update, t)
+				} else {
+					create = append(create, t)
+				}
+			}
+			// each call is retried on its own so a failed update does not
+			// repeat a create that already went through
+			var applied []Term
+			if len(create) > 0 {
+				if applied, err = createTerms(ctx, c, p.Project, opts, create); err != nil {
+					return res, fmt.Errorf("chunk %d/%d: create: %w", i+1, len(batches), err)
+				}
+				res.Created += len(create)
+				// A lost create may have been applied only in part; sending
+				// those terms again as updates makes them whole.
+				update = append(update, applied...)
+			}
+			if len(update) > 0 {
+				if err := retry(ctx, opts, func() error { return c.UpdateTerms(ctx, p.Project, update) }); err != nil {
+					return res, fmt.Errorf("chunk %d/%d: update: %w", i+1, len(batches), err)
+				}
+				res.Updated += len(update) - len(applied)
+			}
+		}
+		if opts.OnChunk != nil {
+			if err := opts.OnChunk(i, len(batches), res); err != nil {
+				return res, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// retry runs call until it succeeds, fails permanently or runs out of
+// attempts. Only idempotent calls may be retried this way.
+func retry(ctx context.Context, opts UploadOptions, call func() error) error {
+	wait := opts.Backoff
+	for attempt := 0; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= opts.Retries || ctx.Err() != nil || !transient(err) {
+			return err
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+		wait *= 2
+	}
+}
+
+// createTerms creates terms, retrying transient failures. Creating is not
+// idempotent and a failed call may still have been applied (a client
+// timeout, a 5xx after the write), so before each retry the project's terms
+// are listed again and only the ones still missing are created. The terms
+// that turned out to exist are returned with their ids, to be updated.
+func createTerms(ctx context.Context, c *Client, project string, opts UploadOptions, create []Term) ([]Term, error) {
+	var applied []Term
+	wait := opts.Backoff
+	for attempt := 0; ; attempt++ {
+		err := c.CreateTerms(ctx, project, create)
+		if err == nil || attempt >= opts.Retries || ctx.Err() != nil || !transient(err) {
+			return applied, err
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return applied, err
+		}
+		wait *= 2
+
+		existing, lerr := c.GlossaryTerms(ctx, project)
+		if lerr != nil {
+			return applied, fmt.Errorf("%w (listing terms before retrying: %v)", err, lerr)
+		}
+		ids := make(map[string]int64, len(existing))
+		for _, t := range existing {
+			ids[t.Term] = t.ID
+		}
+		var missing []Term
+		for _, t := range create {
+			if id, ok := ids[t.Term]; ok {
+				t.ID = id
+				applied = append(applied, t)
+			} else {
+				missing = append(missing, t)
+			}
+		}
+		if len(missing) == 0 {
+			return applied, nil
+		}
+		create = missing
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// transient reports whether a failed call is worth repeating: network
+// errors (including client timeouts), rate limiting and server errors are;
+// other API errors are not.
+func transient(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Status == http.StatusTooManyRequests || apiErr.Status >= 500
+	}
+	return true
+}
+
+func joinInts(xs []int) string {
+	s := make([]string, len(xs))
+	for i, x := range xs {
+		s[i] = fmt.Sprint(x)
+	}
+	return strings.Join(s, ", ")
+}
+
+func humanBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
@@ -0,0 +1,243 @@
+package lokalise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPlan_Batches(t *testing.T) {
+	p := Plan{Terms: make([]Term, 5), BatchSize: 2}
+	got := []int{}
+	for _, b := range p.Batches() {
+		got = append(got, len(b))
+	}
+	if len(got) != 3 || got[0] != 2 || got[2] != 1 {
+		t.Fatalf("unexpected batches: %v", got)
+	}
+}
+
+func TestPlan_WriteDryRun(t *testing.T) {
+	terms, locales, dups, err := TermsFromTable(parse(t, glossary), nil, KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	p := Plan{Project: "p1", Terms: terms, Locales: locales, Duplicates: dups, Policy: KeepFirst, BatchSize: 1}
+	if err := p.WriteDryRun(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"Terms to send: 2",
+		"de-DE -> de_DE",
+		`"API" on lines 2, 4`,
+		"GET  /projects/p1/languages  (resolve 2 code(s): en, de_DE)",
+		"batch 2/2: 1 term(s)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dry run output misses %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestUpload(t *testing.T) {
+	var created, updated []Term
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Token") != "tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/languages"):
+			_, _ = w.Write([]byte(`{"languages":[{"lang_id":640,"lang_iso":"en"},{"lang_id":597,"lang_iso":"de_DE"}]}`))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/glossary-terms"):
+			_, _ = w.Write([]byte(`{"data":[{"id":7,"term":"cart"}],"meta":{}}`))
+		case r.Method == http.MethodPost || r.Method == http.MethodPut:
+			var body struct {
+				Terms []Term `json:"terms"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if r.Method == http.MethodPost {
+				created = append(created, body.Terms...)
+			} else {
+				updated = append(updated, body.Terms...)
+			}
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	terms, locales, _, err := TermsFromTable(parse(t, glossary), nil, KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient("tok")
+	c.BaseURL = srv.URL
+	res, err := Upload(context.Background(), c, Plan{Project: "p1", Terms: terms, Locales: locales}, UploadOptions{})
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if res.Created != 1 || res.Updated != 1 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if created[0].Term != "API" || created[0].Translations[1].LangID != 597 {
+		t.Errorf("unexpected created term: %+v", created[0])
+	}
+	if updated[0].ID != 7 {
+		t.Errorf("update should carry the existing id: %+v", updated[0])
+	}
+
+	_, err = Upload(context.Background(), c, Plan{Project: "p1", Locales: []LocaleMapping{{Column: "fr", Lokalise: "fr"}}}, UploadOptions{})
+	if err == nil || !strings.Contains(err.Error(), "languages not in project p1: fr") {
+		t.Errorf("expected missing language error, got %v", err)
+	}
+}
+
+func TestUpload_RetriesAndSkipsDoneChunks(t *testing.T) {
+	var posts, failures int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/languages"):
+			_, _ = w.Write([]byte(`{"languages":[{"lang_id":640,"lang_iso":"en"},{"lang_id":597,"lang_iso":"de_DE"}]}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":[],"meta":{}}`))
+		case r.Method == http.MethodPost:
+			posts++
+			if failures < 2 {
+				failures++
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}
+	}))
+	defer srv.Close()
+
+	terms, locales, _, err := TermsFromTable(parse(t, glossary), nil, KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient("tok")
+	c.BaseURL = srv.URL
+	p := Plan{Project: "p1", Terms: terms, Locales: locales, BatchSize: 1}
+
+	var chunks []int
+	res, err := Upload(context.Background(), c, p, UploadOptions{
+		Retries: 2,
+		Done:    map[int]bool{0: true},
+		OnChunk: func(chunk, total int, _ Result) error {
+			chunks = append(chunks, chunk)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if res.Created != 1 || res.Skipped != 1 || posts != 3 {
+		t.Fatalf("want chunk 1 created after 2 retries, chunk 0 skipped; got %+v, %d posts", res, posts)
+	}
+	if len(chunks) != 2 {
+		t.Errorf("OnChunk should run for skipped and uploaded chunks, got %v", chunks)
+	}
+
+	failures = -10 // fail for good
+	_, err = Upload(context.Background(), c, p, UploadOptions{Retries: 1})
+	if err == nil || !strings.Contains(err.Error(), "chunk 1/2: create") {
+		t.Errorf("expected chunk error, got %v", err)
+	}
+}
+
+func TestUpload_CreateTimedOutAfterApplied(t *testing.T) {
+	var mu sync.Mutex
+	stored := map[string]int64{}
+	var posts, puts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/languages"):
+			_, _ = w.Write([]byte(`{"languages":[{"lang_id":640,"lang_iso":"en"},{"lang_id":597,"lang_iso":"de_DE"}]}`))
+		case r.Method == http.MethodGet:
+			var data []Term
+			for term, id := range stored {
+				data = append(data, Term{ID: id, Term: term})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": data, "meta": map[string]any{}})
+		case r.Method == http.MethodPost:
+			posts++
+			var body struct {
+				Terms []Term `json:"terms"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			for _, term := range body.Terms {
+				stored[term.Term] = int64(100 + len(stored))
+			}
+			if posts == 1 {
+				// Applied, but the answer comes after the client gave up.
+				mu.Unlock()
+				time.Sleep(300 * time.Millisecond)
+				mu.Lock()
+			}
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		case r.Method == http.MethodPut:
+			puts++
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}
+	}))
+	defer srv.Close()
+
+	terms, locales, _, err := TermsFromTable(parse(t, glossary), nil, KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient("tok")
+	c.BaseURL = srv.URL
+	c.HTTP = &http.Client{Timeout: 100 * time.Millisecond}
+	res, err := Upload(context.Background(), c, Plan{Project: "p1", Terms: terms, Locales: locales}, UploadOptions{Retries: 2})
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if posts != 1 || len(stored) != len(terms) {
+		t.Fatalf("create repeated after it was applied: %d posts, %d terms stored", posts, len(stored))
+	}
+	if puts != 1 || res.Created != len(terms) || res.Updated != 0 {
+		t.Fatalf("applied terms should be sent once more as updates: %d puts, %+v", puts, res)
+	}
+}
+
+func TestResumeState(t *testing.T) {
+	path := t.TempDir() + "/state.json"
+	p := Plan{Project: "p1", Terms: make([]Term, 3), BatchSize: 1}
+
+	st, stale, err := LoadResume(path, p)
+	if err != nil || stale || len(st.Done) != 0 || st.Chunks != 3 {
+		t.Fatalf("fresh state: %+v stale=%v err=%v", st, stale, err)
+	}
+	st.MarkDone(1)
+	st.MarkDone(1)
+	if err := st.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	st, stale, err = LoadResume(path, p)
+	if err != nil || stale || !st.DoneSet()[1] || len(st.Done) != 1 {
+		t.Fatalf("reloaded state: %+v stale=%v err=%v", st, stale, err)
+	}
+
+	p.BatchSize = 2
+	st, stale, err = LoadResume(path, p)
+	if err != nil || !stale || len(st.Done) != 0 {
+		t.Fatalf("changed plan should start over: %+v stale=%v err=%v", st, stale, err)
+	}
+}
@@ -0,0 +1,91 @@
+package lokalise
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+)
+
+// ResumeState records which chunks of an upload succeeded, so a rerun of
+// the same plan can skip them. It is tied to the plan by a digest of the
+// project, the chunk size and the terms; any change starts over.
+type ResumeState struct {
+	Project string `json:"project"`
+	Digest  string `json:"digest"`
+	Chunks  int    `json:"chunks"`
+	Done    []int  `json:"done"` // 0-based chunk indexes
+}
+
+// Digest identifies the chunks a plan sends.
+func (p Plan) Digest() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%d\n", p.Project, len(p.Batches()))
+	for _, b := range p.Batches() {
+		_ = json.NewEncoder(h).Encode(b)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LoadResume reads the state at path for p. A missing file, or one written
+// for a different plan, yields a fresh state; stale reports the latter.
+func LoadResume(path string, p Plan) (st *ResumeState, stale bool, err error) {
+	fresh := &ResumeState{Project: p.Project, Digest: p.Digest(), Chunks: len(p.Batches())}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fresh, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var saved ResumeState
+	if err := json.Unmarshal(raw, &saved); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", path, err)
+	}
+	if saved.Project != fresh.Project || saved.Digest != fresh.Digest {
+		return fresh, true, nil
+	}
+	return &saved, false, nil
+}
+
+// DoneSet returns the finished chunks for UploadOptions.Done.
+func (s *ResumeState) DoneSet() map[int]bool {
+	done := make(map[int]bool, len(s.Done))
+	for _, i := range s.Done {
+		done[i] = true
+	}
+	return done
+}
+
+// MarkDone records chunk i as uploaded.
+func (s *ResumeState) MarkDone(i int) {
+	if !slices.Contains(s.Done, i) {
+		s.Done = append(s.Done, i)
+	}
+}
+
+// Save writes the state atomically, so an interrupted save never leaves a
+// truncated file behind.
+func (s *ResumeState) Save(path string) error {
+	raw, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFile(path, append(raw, '\n'), 0o644)
+}
+
+// DefaultResumePath is where upload keeps the state for a glossary file.
+func DefaultResumePath(file string) string {
+	return file + ".upload-state.json"
+}
+
+func (s *ResumeState) String() string {
+	return strconv.Itoa(len(s.Done)) + "/" + strconv.Itoa(s.Chunks) + " chunk(s) done"
+}
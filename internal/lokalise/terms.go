@@ -0,0 +1,163 @@
+package lokalise
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+)
+
+// Term is a glossary term in the shape of the Lokalise API.
+type Term struct {
+	ID            int64         `json:"id,omitempty"`
+	Term          string        `json:"term"`
+	Description   string        `json:"description"`
+	CaseSensitive bool          `json:"caseSensitive"`
+	Translatable  bool          `json:"translatable"`
+	Forbidden     bool          `json:"forbidden"`
+	Translations  []Translation `json:"translations"`
+	Tags          []string      `json:"tags"`
+
+	// Line is the CSV line the term came from; not sent to the API.
+	Line int `json:"-"`
+}
+
+// Translation is a per-language value of a term. LangISO is the Lokalise
+// language code, resolved to LangID before sending.
+type Translation struct {
+	LangID      int    `json:"langId"`
+	LangISO     string `json:"-"`
+	Translation string `json:"translation"`
+	Description string `json:"description"`
+}
+
+// LocaleMapping maps a CSV language column to a Lokalise language code.
+type LocaleMapping struct {
+	Column   string
+	Lokalise string
+}
+
+// Duplicate is a term that appears on several lines of the file.
+type Duplicate struct {
+	Term  string
+	Lines []int
+}
+
+// Duplicate handling policies.
+const (
+	KeepFirst     = "first"
+	KeepLast      = "last"
+	FailDuplicate = "fail"
+)
+
+// LokaliseCode maps a CSV language column to a Lokalise language code:
+// an explicit entry in overrides wins, otherwise "-" becomes "_" (pt-BR ->
+// pt_BR).
+func LokaliseCode(column string, overrides map[string]string) string {
+	if code, ok := overrides[column]; ok {
+		return code
+	}
+	return strings.ReplaceAll(column, "-", "_")
+}
+
+// TermsFromTable converts glossary rows into API terms. Duplicate terms
+// (exact match after trimming) are resolved by policy; the duplicates found
+// are returned either way.
+func TermsFromTable(tbl *csvutil.Table, localeMap map[string]string, policy string) ([]Term, []LocaleMapping, []Duplicate, error) {
+	termCol := tbl.Col("term")
+	if termCol < 0 {
+		return nil, nil, nil, fmt.Errorf("no 'term' column")
+	}
+	descCol := tbl.Col("description")
+	csCol, trCol, fbCol := tbl.Col("casesensitive"), tbl.Col("translatable"), tbl.Col("forbidden")
+	tagsCol := tbl.Col("tags")
+
+	type langCol struct {
+		idx, descIdx int
+		code         string
+	}
+	var langs []langCol
+	var mappings []LocaleMapping
+	for _, i := range tbl.LangCols() {
+		name := strings.TrimSpace(tbl.Header[i])
+		lc := langCol{idx: i, descIdx: tbl.Col(name + "_description"), code: LokaliseCode(name, localeMap)}
+		langs = append(langs, lc)
+		mappings = append(mappings, LocaleMapping{Column: name, Lokalise: lc.code})
+	}
+
+	var terms []Term
+	seen := map[string]int{} // term -> index in terms
+	lines := map[string][]int{}
+	var order []string
+	for _, row := range tbl.Rows {
+		name := strings.TrimSpace(row.Get(termCol))
+		if name == "" {
+			continue
+		}
+		t := Term{
+			Term:          name,
+			Description:   strings.TrimSpace(row.Get(descCol)),
+			CaseSensitive: flag(row.Get(csCol), false),
+			Translatable:  flag(row.Get(trCol), true),
+			Forbidden:     flag(row.Get(fbCol), false),
+			Tags:          splitTags(row.Get(tagsCol)),
+			Line:          row.Line,
+		}
+		for _, l := range langs {
+			tr := Translation{
+				LangISO:     l.code,
+				Translation: strings.TrimSpace(row.Get(l.idx)),
+				Description: strings.TrimSpace(row.Get(l.descIdx)),
+			}
+			if tr.Translation != "" || tr.Description != "" {
+				t.Translations = append(t.Translations, tr)
+			}
+		}
+
+		if len(lines[name]) == 0 {
+			order = append(order, name)
+		}
+		lines[name] = append(lines[name], row.Line)
+		if i, dup := seen[name]; dup {
+			if policy == KeepLast {
+				terms[i] = t
+			}
+			continue
+		}
+		seen[name] = len(terms)
+		terms = append(terms, t)
+	}
+
+	var dups []Duplicate
+	for _, name := range order {
+		if len(lines[name]) > 1 {
+			dups = append(dups, Duplicate{Term: name, Lines: lines[name]})
+		}
+	}
+	if policy == FailDuplicate && len(dups) > 0 {
+		return nil, mappings, dups, fmt.Errorf("%d duplicate term(s), first %q on lines %v", len(dups), dups[0].Term, dups[0].Lines)
+	}
+	return terms, mappings, dups, nil
+}
+
+// flag reads a yes/no cell; anything else yields def.
+func flag(v string, def bool) bool {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "yes":
+		return true
+	case "no":
+		return false
+	default:
+		return def
+	}
+}
+
+func splitTags(v string) []string {
+	tags := []string{}
+	for _, t := range strings.Split(v, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
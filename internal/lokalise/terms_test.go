@@ -0,0 +1,73 @@
+package lokalise
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/csvutil"
+)
+
+const glossary = "term;description;casesensitive;translatable;forbidden;tags;en;de-DE;de-DE_description\n" +
+	"API;interface;yes;no;no;tech, dev ,;API;API;\n" +
+	"cart;basket;;;;;cart;Warenkorb;Einkaufswagen\n" +
+	"API;second;no;yes;no;;API2;;\n"
+
+func parse(t *testing.T, s string) *csvutil.Table {
+	t.Helper()
+	tbl, err := csvutil.Parse([]byte(s))
+	if err != nil || tbl == nil {
+		t.Fatalf("parse: %v", err)
+	}
+	return tbl
+}
+
+func TestTermsFromTable(t *testing.T) {
+	terms, locales, dups, err := TermsFromTable(parse(t, glossary), nil, KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(terms) != 2 || len(dups) != 1 || dups[0].Term != "API" || len(dups[0].Lines) != 2 {
+		t.Fatalf("unexpected terms/dups: %+v %+v", terms, dups)
+	}
+	if len(locales) != 2 || locales[1] != (LocaleMapping{Column: "de-DE", Lokalise: "de_DE"}) {
+		t.Fatalf("unexpected locales: %+v", locales)
+	}
+
+	api := terms[0]
+	if api.Description != "interface" || !api.CaseSensitive || api.Translatable || api.Forbidden {
+		t.Errorf("unexpected flags/description: %+v", api)
+	}
+	if len(api.Tags) != 2 || api.Tags[0] != "tech" || api.Tags[1] != "dev" {
+		t.Errorf("unexpected tags: %q", api.Tags)
+	}
+
+	cart := terms[1]
+	if cart.CaseSensitive || !cart.Translatable || cart.Forbidden {
+		t.Errorf("empty flags should use defaults: %+v", cart)
+	}
+	if len(cart.Translations) != 2 || cart.Translations[1] != (Translation{LangISO: "de_DE", Translation: "Warenkorb", Description: "Einkaufswagen"}) {
+		t.Errorf("unexpected translations: %+v", cart.Translations)
+	}
+}
+
+func TestTermsFromTable_DuplicatePolicies(t *testing.T) {
+	terms, _, _, err := TermsFromTable(parse(t, glossary), nil, KeepLast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if terms[0].Description != "second" {
+		t.Errorf("keep last: got %q", terms[0].Description)
+	}
+
+	if _, _, _, err := TermsFromTable(parse(t, glossary), nil, FailDuplicate); err == nil {
+		t.Error("fail policy: expected an error")
+	}
+}
+
+func TestLokaliseCode(t *testing.T) {
+	m := map[string]string{"de": "de_DE"}
+	for in, want := range map[string]string{"de": "de_DE", "pt-BR": "pt_BR", "en": "en"} {
+		if got := LokaliseCode(in, m); got != want {
+			t.Errorf("%s: got %s, want %s", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package lokalise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Mismatch is a field of an uploaded term whose value in the project
+// differs from the local file.
+type Mismatch struct {
+	Term   string `json:"term"`
+	Field  string `json:"field"` // e.g. "description", "translation[de_DE]"
+	Local  string `json:"local"`
+	Remote string `json:"remote"`
+}
+
+// Verification compares the uploaded terms with the project glossary.
+type Verification struct {
+	Checked int `json:"checked"`
+	// Missing lists terms that are not in the project (rejected).
+	Missing []string `json:"missing"`
+	// Changed lists fields the project stores differently (modified).
+	Changed []Mismatch `json:"changed"`
+}
+
+// OK reports whether every term arrived unchanged.
+func (v Verification) OK() bool {
+	return len(v.Missing) == 0 && len(v.Changed) == 0
+}
+
+// Verify downloads the project glossary again and diffs it against the
+// terms of p.
+func Verify(ctx context.Context, c *Client, p Plan) (Verification, error) {
+	langs, err := c.Languages(ctx, p.Project)
+	if err != nil {
+		return Verification{}, fmt.Errorf("list languages: %w", err)
+	}
+	isoByID := make(map[int]string, len(langs))
+	for _, l := range langs {
+		isoByID[l.ID] = l.ISO
+	}
+	remote, err := c.GlossaryTerms(ctx, p.Project)
+	if err != nil {
+		return Verification{}, fmt.Errorf("list glossary terms: %w", err)
+	}
+	return DiffTerms(p.Terms, remote, isoByID), nil
+}
+
+// DiffTerms matches local terms to remote ones by exact term text and
+// lists what is missing or different. Remote translations are keyed by
+// language id; isoByID maps them to the codes local terms use. Remote terms
+// absent from local are not reported: the project may hold other terms.
+func DiffTerms(local, remote []Term, isoByID map[int]string) Verification {
+	byTerm := make(map[string]Term, len(remote))
+	for _, t := range remote {
+		byTerm[t.Term] = t
+	}
+
+	v := Verification{Checked: len(local)}
+	for _, l := range local {
+		r, ok := byTerm[l.Term]
+		if !ok {
+			v.Missing = append(v.Missing, l.Term)
+			continue
+		}
+		diff := func(field, local, remote string) {
+			if local != remote {
+				v.Changed = append(v.Changed, Mismatch{Term: l.Term, Field: field, Local: local, Remote: remote})
+			}
+		}
+		diff("description", l.Description, r.Description)
+		diff("casesensitive", yesNo(l.CaseSensitive), yesNo(r.CaseSensitive))
+		diff("translatable", yesNo(l.Translatable), yesNo(r.Translatable))
+		diff("forbidden", yesNo(l.Forbidden), yesNo(r.Forbidden))
+		diff("tags", sortedJoin(l.Tags), sortedJoin(r.Tags))
+
+		remoteTr := make(map[string]Translation, len(r.Translations))
+		for _, tr := range r.Translations {
+			iso := tr.LangISO
+			if iso == "" {
+				iso = isoByID[tr.LangID]
+			}
+			remoteTr[iso] = tr
+		}
+		for _, tr := range l.Translations {
+			rt := remoteTr[tr.LangISO]
+			diff("translation["+tr.LangISO+"]", tr.Translation, rt.Translation)
+			diff("description["+tr.LangISO+"]", tr.Description, rt.Description)
+		}
+	}
+	return v
+}
+
+// WriteVerification prints a verification in the style of the dry run.
+func WriteVerification(w io.Writer, v Verification) {
+	fmt.Fprintf(w, "Verification: %d term(s) checked, %d missing, %d field(s) changed\n",
+		v.Checked, len(v.Missing), len(v.Changed))
+	for _, t := range v.Missing {
+		fmt.Fprintf(w, "  missing: %q\n", t)
+	}
+	for _, m := range v.Changed {
+		fmt.Fprintf(w, "  changed: %q %s: %s -> %s\n", m.Term, m.Field, strconv.Quote(m.Local), strconv.Quote(m.Remote))
+	}
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func sortedJoin(xs []string) string {
+	s := slices.Clone(xs)
+	slices.Sort(s)
+	return strings.Join(s, ",")
+}
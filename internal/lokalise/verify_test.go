@@ -0,0 +1,38 @@
+package lokalise
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDiffTerms(t *testing.T) {
+	local := []Term{
+		{Term: "cart", Description: "basket", Translatable: true, Tags: []string{"ui", "shop"},
+			Translations: []Translation{{LangISO: "de_DE", Translation: "Warenkorb"}}},
+		{Term: "API", Translatable: true},
+	}
+	remote := []Term{
+		{Term: "cart", Description: "basket", Translatable: true, Tags: []string{"shop", "ui"},
+			Translations: []Translation{{LangID: 597, Translation: "Einkaufswagen"}}},
+		{Term: "unrelated"},
+	}
+
+	v := DiffTerms(local, remote, map[int]string{597: "de_DE"})
+	if v.OK() || v.Checked != 2 {
+		t.Fatalf("unexpected verification: %+v", v)
+	}
+	if len(v.Missing) != 1 || v.Missing[0] != "API" {
+		t.Errorf("missing: %v", v.Missing)
+	}
+	want := Mismatch{Term: "cart", Field: "translation[de_DE]", Local: "Warenkorb", Remote: "Einkaufswagen"}
+	if len(v.Changed) != 1 || v.Changed[0] != want {
+		t.Errorf("changed: %+v", v.Changed)
+	}
+
+	var buf bytes.Buffer
+	WriteVerification(&buf, v)
+	if !strings.Contains(buf.String(), `changed: "cart" translation[de_DE]: "Warenkorb" -> "Einkaufswagen"`) {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,385 @@
+// Package policy fetches a shared policy bundle: a tar.gz archive (or a git
+// ref) holding a policy.yaml in the config file format plus any files it
+// refers to. Bundles are cached per source, so a platform team can publish
+// one policy that many repositories pin and reuse offline.
+package policy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+)
+
+// File is the config file expected at the root of a bundle.
+const File = "policy.yaml"
+
+// maxArchive bounds a downloaded bundle; policies are small. maxUnpacked and
+// maxEntries bound what it unpacks to, so a small archive cannot expand
+// without limit.
+const (
+	maxArchive  = 10 << 20
+	maxUnpacked = 50 << 20
+	maxEntries  = 10000
+)
+
+// gitPrefix marks git sources: git+https://host/repo.git#ref.
+const gitPrefix = "git+"
+
+// Source is where a bundle comes from and, optionally, what it must hash to.
+type Source struct {
+	// URL is an http(s) URL of a .tar.gz bundle or a single YAML file, or
+	// git+<repo>#<ref>.
+	URL string `yaml:"url"`
+	// SHA256 pins the downloaded archive (hex). A pinned bundle that is
+	// cached is used without network access.
+	SHA256 string `yaml:"sha256"`
+}
+
+// Bundle is a fetched policy on disk.
+type Bundle struct {
+	Dir    string // extracted bundle
+	Digest string // sha256 of the archive, or the commit of a git source
+	Cached bool   // served from the cache because the source was pinned or unreachable
+}
+
+// Config returns the path of the bundle's policy.yaml.
+func (b Bundle) Config() string {
+	return filepath.Join(b.Dir, File)
+}
+
+// DefaultCacheDir is the bundle cache in the user cache directory, or in the
+// working directory when there is none.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ".glossaryguard-policy"
+	}
+	return filepath.Join(dir, "lokalise-glossary-guard", "policy")
+}
+
+var commitRe = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// gitRepoRe lists the repository forms a git source may use: https, ssh and
+// file URLs, and scp-like user@host:path. Anything else, notably option-like
+// strings and the ext:: transport, would let a config run commands.
+var gitRepoRe = regexp.MustCompile(`^(https://|ssh://|file://|[A-Za-z0-9._-]+@[A-Za-z0-9.-]+:[^-])`)
+
+// gitConfig keeps git itself to the same transports, whatever the repo
+// string turns into on the way (redirects, submodules, insteadOf rules).
+var gitConfig = []string{
+	"-c", "protocol.allow=never",
+	"-c", "protocol.https.allow=always",
+	"-c", "protocol.ssh.allow=always",
+	"-c", "protocol.file.allow=always",
+}
+
+// Fetch returns the bundle for src, downloading it unless a pinned copy is
+// cached. Unpinned sources are fetched on every run; when that fails a
+// cached copy is used and warn is called.
+func Fetch(ctx context.Context, src Source, cacheDir string, warn func(string)) (Bundle, error) {
+	if src.URL == "" {
+		return Bundle{}, errors.New("policy: no source URL")
+	}
+	isGit := strings.HasPrefix(src.URL, gitPrefix)
+	pin := strings.ToLower(src.SHA256)
+	if isGit && pin != "" {
+		return Bundle{}, errors.New("policy: sha256 applies to archive URLs; pin a git policy by commit (git+<repo>#<commit>)")
+	}
+	if isGit {
+		repo, ref := splitGit(src.URL)
+		if err := checkGit(repo, ref); err != nil {
+			return Bundle{}, err
+		}
+		if commitRe.MatchString(ref) {
+			pin = ref
+		}
+	}
+
+	sum := sha256.Sum256([]byte(src.URL))
+	slot := filepath.Join(cacheDir, hex.EncodeToString(sum[:8]))
+	cached, cachedOK := readCached(slot)
+	if pin != "" && cachedOK && cached.Digest == pin {
+		cached.Cached = true
+		return cached, nil
+	}
+
+	var b Bundle
+	var err error
+	if isGit {
+		b, err = fetchGit(ctx, src.URL, slot)
+	} else {
+		b, err = fetchArchive(ctx, src.URL, pin, slot)
+	}
+	if err != nil {
+		if pin == "" && cachedOK {
+			if warn != nil {
+				warn(fmt.Sprintf("policy: %v; using the cached copy", err))
+			}
+			cached.Cached = true
+			return cached, nil
+		}
+		return Bundle{}, err
+	}
+	if pin != "" && b.Digest != pin {
+		return Bundle{}, fmt.Errorf("policy: %s does not match the pinned checksum (got %s, want %s)", src.URL, b.Digest, pin)
+	}
+	return b, nil
+}
+
+// readCached returns the bundle stored in slot, if complete.
+func readCached(slot string) (Bundle, bool) {
+	raw, err := os.ReadFile(filepath.Join(slot, "digest"))
+	if err != nil {
+		return Bundle{}, false
+	}
+	b := Bundle{Dir: filepath.Join(slot, "bundle"), Digest: strings.TrimSpace(string(raw))}
+	if _, err := os.Stat(b.Config()); err != nil {
+		return Bundle{}, false
+	}
+	return b, true
+}
+
+func fetchArchive(ctx context.Context, url, pin, slot string) (Bundle, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("policy: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("policy: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Bundle{}, fmt.Errorf("policy: GET %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxArchive+1))
+	if err != nil {
+		return Bundle{}, fmt.Errorf("policy: GET %s: %w", url, err)
+	}
+	if len(data) > maxArchive {
+		return Bundle{}, fmt.Errorf("policy: %s is larger than %d bytes", url, maxArchive)
+	}
+
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	if pin != "" && digest != pin {
+		return Bundle{}, fmt.Errorf("policy: %s does not match the pinned checksum (got %s, want %s)", url, digest, pin)
+	}
+	return install(slot, digest, func(dir string) error {
+		if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+			// Not gzip: the URL points at the policy file itself.
+			return os.WriteFile(filepath.Join(dir, File), data, 0o644)
+		}
+		return extract(data, dir)
+	})
+}
+
+func fetchGit(ctx context.Context, src, slot string) (Bundle, error) {
+	repo, ref := splitGit(src)
+	var digest string
+	b, err := install(slot, "", func(dir string) error {
+		git := func(args ...string) (string, error) {
+			argv := append(append([]string{"-C", dir}, gitConfig...), args...)
+			cmd := exec.CommandContext(ctx, "git", argv...)
+			var stderr bytes.Buffer
+			cmd.Stderr = &stderr
+			out, err := cmd.Output()
+			if err != nil {
+				return "", fmt.Errorf("policy: git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+			}
+			return strings.TrimSpace(string(out)), nil
+		}
+		if _, err := git("init", "-q"); err != nil {
+			return err
+		}
+		if _, err := git("fetch", "-q", "--depth", "1", "--", repo, ref); err != nil {
+			return err
+		}
+		if _, err := git("checkout", "-q", "FETCH_HEAD"); err != nil {
+			return err
+		}
+		commit, err := git("rev-parse", "HEAD")
+		if err != nil {
+			return err
+		}
+		digest = commit
+		return os.RemoveAll(filepath.Join(dir, ".git"))
+	})
+	if err != nil {
+		return Bundle{}, err
+	}
+	b.Digest = digest
+	return b, os.WriteFile(filepath.Join(slot, "digest"), []byte(digest+"\n"), 0o644)
+}
+
+// splitGit splits git+<repo>#<ref>; the ref defaults to HEAD.
+func splitGit(src string) (repo, ref string) {
+	repo, ref, _ = strings.Cut(strings.TrimPrefix(src, gitPrefix), "#")
+	if ref == "" {
+		ref = "HEAD"
+	}
+	return repo, ref
+}
+
+// checkGit rejects a git source that is not a plain repository URL and ref,
+// since both end up on the git command line.
+func checkGit(repo, ref string) error {
+	if !gitRepoRe.MatchString(repo) {
+		return fmt.Errorf("policy: git repository %q must be an https://, ssh:// or file:// URL, or user@host:path", repo)
+	}
+	if strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("policy: git ref %q must not start with \"-\"", ref)
+	}
+	return nil
+}
+
+// install fills a fresh directory with fill and swaps it in as the bundle of
+// slot, so a failed fetch never damages the cached copy. An empty digest is
+// left for the caller to record.
+func install(slot, digest string, fill func(dir string) error) (Bundle, error) {
+	if err := os.MkdirAll(slot, 0o755); err != nil {
+		return Bundle{}, err
+	}
+	tmp, err := os.MkdirTemp(slot, ".fetch-*")
+	if err != nil {
+		return Bundle{}, err
+	}
+	defer os.RemoveAll(tmp)
+	if err := fill(tmp); err != nil {
+		return Bundle{}, err
+	}
+	if _, err := os.Stat(filepath.Join(tmp, File)); err != nil {
+		return Bundle{}, fmt.Errorf("policy: bundle has no %s at its root", File)
+	}
+
+	dir := filepath.Join(slot, "bundle")
+	_ = os.Remove(filepath.Join(slot, "digest"))
+	if err := os.RemoveAll(dir); err != nil {
+		return Bundle{}, err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		return Bundle{}, err
+	}
+	if digest != "" {
+		if err := fsutil.WriteFile(filepath.Join(slot, "digest"), []byte(digest+"\n"), 0o644); err != nil {
+			return Bundle{}, err
+		}
+	}
+	return Bundle{Dir: dir, Digest: digest}, nil
+}
+
+// extract unpacks a tar.gz into dir, writing entries out as they are read.
+// Only regular files and directories are kept; entries escaping dir are
+// rejected, and so are archives that unpack to more than maxUnpacked bytes
+// or maxEntries entries. A single top-level directory (as in GitHub release
+// archives) is stripped.
+func extract(data []byte, dir string) error {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("policy: %w", err)
+	}
+	stage, err := os.MkdirTemp(dir, ".extract-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stage)
+
+	tr := tar.NewReader(zr)
+	var first string
+	var total int64
+	for n := 0; ; n++ {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("policy: read archive: %w", err)
+		}
+		if n == maxEntries {
+			return fmt.Errorf("policy: archive has more than %d entries", maxEntries)
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(h.Name, "./"))
+		if !localName(name) {
+			return fmt.Errorf("policy: archive entry %q escapes the bundle", h.Name)
+		}
+		if first == "" {
+			first = name
+		}
+		written, err := writeEntry(filepath.Join(stage, filepath.FromSlash(name)), tr, maxUnpacked-total)
+		if err != nil {
+			return err
+		}
+		total += written
+	}
+
+	root := stage
+	if _, err := os.Stat(filepath.Join(stage, File)); err != nil {
+		if top, _, ok := strings.Cut(first, "/"); ok {
+			if _, err := os.Stat(filepath.Join(stage, top, File)); err == nil {
+				root = filepath.Join(stage, top)
+			}
+		}
+	}
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := os.Rename(filepath.Join(root, e.Name()), filepath.Join(dir, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// localName reports whether an archive entry name stays inside the bundle on
+// every platform: backslashes and drive letters, harmless as names on Unix,
+// are separators and volumes on Windows.
+func localName(name string) bool {
+	return fs.ValidPath(name) && !strings.ContainsAny(name, `\:`) && filepath.IsLocal(filepath.FromSlash(name))
+}
+
+// writeEntry copies one archive entry to p, failing once more than budget
+// bytes would be written.
+func writeEntry(p string, r io.Reader, budget int64) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, io.LimitReader(r, budget+1))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return n, fmt.Errorf("policy: read archive: %w", err)
+	}
+	if n > budget {
+		return n, fmt.Errorf("policy: archive unpacks to more than %d bytes", maxUnpacked)
+	}
+	return n, nil
+}
@@ -0,0 +1,208 @@
+package policy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for name, body := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(body)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func digest(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestFetchArchivePinnedAndCached(t *testing.T) {
+	archive := tarGz(t, map[string]string{
+		"policy-v1/policy.yaml":   "checks:\n  warn-term-casing:\n    policy: lowercase\n",
+		"policy-v1/deny/list.txt": "foo\n",
+	})
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		_, _ = w.Write(archive)
+	}))
+	defer srv.Close()
+
+	cache := t.TempDir()
+	src := Source{URL: srv.URL + "/policy.tar.gz", SHA256: digest(archive)}
+	b, err := Fetch(context.Background(), src, cache, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Cached || b.Digest != src.SHA256 {
+		t.Fatalf("first fetch = %+v", b)
+	}
+	if raw, err := os.ReadFile(b.Config()); err != nil || !strings.Contains(string(raw), "lowercase") {
+		t.Fatalf("policy.yaml = %q, %v", raw, err)
+	}
+	if _, err := os.Stat(filepath.Join(b.Dir, "deny", "list.txt")); err != nil {
+		t.Fatalf("bundle file missing: %v", err)
+	}
+
+	srv.Close()
+	b, err = Fetch(context.Background(), src, cache, nil)
+	if err != nil || !b.Cached {
+		t.Fatalf("pinned refetch = %+v, %v", b, err)
+	}
+	if hits != 1 {
+		t.Fatalf("server hit %d times, want 1", hits)
+	}
+}
+
+func TestFetchChecksumMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("checks: {}\n"))
+	}))
+	defer srv.Close()
+
+	_, err := Fetch(context.Background(), Source{URL: srv.URL, SHA256: strings.Repeat("0", 64)}, t.TempDir(), nil)
+	if err == nil || !strings.Contains(err.Error(), "pinned checksum") {
+		t.Fatalf("err = %v, want checksum mismatch", err)
+	}
+}
+
+func TestFetchUnpinnedFallsBackToCache(t *testing.T) {
+	up := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up {
+			http.Error(w, "down", http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte("upload:\n  project-id: p1\n"))
+	}))
+	defer srv.Close()
+
+	cache := t.TempDir()
+	src := Source{URL: srv.URL + "/policy.yaml"}
+	if _, err := Fetch(context.Background(), src, cache, nil); err != nil {
+		t.Fatal(err)
+	}
+	up = false
+	var warned string
+	b, err := Fetch(context.Background(), src, cache, func(msg string) { warned = msg })
+	if err != nil || !b.Cached {
+		t.Fatalf("fallback = %+v, %v", b, err)
+	}
+	if !strings.Contains(warned, "using the cached copy") {
+		t.Fatalf("warning = %q", warned)
+	}
+}
+
+func TestExtractRejectsEscapingEntries(t *testing.T) {
+	for _, evil := range []string{"../evil", `..\..\AppData\evil`, `C:\evil`, "c:evil", "deny/../../evil", "/etc/evil"} {
+		dir := t.TempDir()
+		archive := tarGz(t, map[string]string{"policy.yaml": "", evil: "x"})
+		err := extract(archive, dir)
+		if err == nil || !strings.Contains(err.Error(), "escapes the bundle") {
+			t.Errorf("%s: err = %v", evil, err)
+		}
+	}
+}
+
+func TestFetchRejectsSHA256ForGit(t *testing.T) {
+	_, err := Fetch(context.Background(), Source{URL: "git+https://example.com/p.git#main", SHA256: "ab"}, t.TempDir(), nil)
+	if err == nil {
+		t.Fatal("want error")
+	}
+}
+
+func TestFetchRejectsUnsafeGitSources(t *testing.T) {
+	dir := t.TempDir()
+	pwned := filepath.Join(dir, "PWNED")
+	for _, url := range []string{
+		"git+--upload-pack=touch " + pwned + "; false#" + dir,
+		"git+ext::sh -c touch% " + pwned + "#main",
+		"git+https://example.com/p.git#--upload-pack=touch " + pwned,
+		"git+/tmp/repo#main",
+		"git+git@example.com:-oProxyCommand=x#main",
+	} {
+		_, err := Fetch(context.Background(), Source{URL: url}, t.TempDir(), nil)
+		if err == nil || !strings.Contains(err.Error(), "must") {
+			t.Errorf("%s: err = %v", url, err)
+		}
+	}
+	if _, err := os.Stat(pwned); err == nil {
+		t.Fatal("git ran a command from the source URL")
+	}
+	for _, ok := range []string{"https://github.com/acme/p.git", "ssh://git@host/p.git", "file:///srv/p.git", "git@github.com:acme/p.git"} {
+		if err := checkGit(ok, "v3"); err != nil {
+			t.Errorf("%s: %v", ok, err)
+		}
+	}
+}
+
+func TestExtractLimits(t *testing.T) {
+	// Highly compressible: a few KB of gzip unpacking past maxUnpacked.
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for i := 0; i < 6; i++ {
+		if err := tw.WriteHeader(&tar.Header{Name: fmt.Sprintf("big%d", i), Mode: 0o644, Size: 10 << 20, Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(make([]byte, 10<<20)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = tw.Close()
+	_ = zw.Close()
+	if err := extract(buf.Bytes(), t.TempDir()); err == nil || !strings.Contains(err.Error(), "more than") {
+		t.Fatalf("bomb: err = %v", err)
+	}
+
+	files := map[string]string{"policy.yaml": ""}
+	for i := 0; i < maxEntries; i++ {
+		files[fmt.Sprintf("f%d", i)] = ""
+	}
+	if err := extract(tarGz(t, files), t.TempDir()); err == nil || !strings.Contains(err.Error(), "entries") {
+		t.Fatalf("entries: err = %v", err)
+	}
+}
+
+func TestExtractStripsTopDirectory(t *testing.T) {
+	dir := t.TempDir()
+	archive := tarGz(t, map[string]string{"v1/policy.yaml": "checks: {}\n", "v1/deny/list.txt": "foo\n"})
+	if err := extract(archive, dir); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{File, "deny/list.txt"} {
+		if _, err := os.Stat(filepath.Join(dir, p)); err != nil {
+			t.Errorf("%s: %v", p, err)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Errorf("leftovers in bundle: %v", entries)
+	}
+}
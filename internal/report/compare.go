@@ -0,0 +1,126 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Issue is a non-passing check (or an operational error) in a report.
+type Issue struct {
+	File    string `json:"file"`
+	Check   string `json:"check"`
+	Code    string `json:"code,omitempty"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Diff is the result of comparing a baseline report with a newer one.
+type Diff struct {
+	Introduced []Issue `json:"introduced"`
+	Resolved   []Issue `json:"resolved"`
+	Unchanged  []Issue `json:"unchanged"`
+}
+
+// Read loads a report written by "validate --json" and rejects other schema
+// versions.
+func Read(path string) (Report, error) {
+	var r Report
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return r, err
+	}
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return r, fmt.Errorf("%s: %w", path, err)
+	}
+	if r.SchemaVersion != SchemaVersion {
+		return r, fmt.Errorf("%s: unsupported schema_version %d (expected %d)", path, r.SchemaVersion, SchemaVersion)
+	}
+	return r, nil
+}
+
+// Issues lists FAIL/ERROR checks and operational errors, plus WARN checks
+// when withWarnings is set.
+func (r Report) Issues(withWarnings bool) []Issue {
+	var out []Issue
+	for _, f := range r.Files {
+		if f.OpError != "" {
+			out = append(out, Issue{File: f.Path, Check: OpErrorRule, Status: "ERROR", Message: f.OpError})
+		}
+		if f.Summary == nil {
+			continue
+		}
+		for _, c := range f.Summary.Checks {
+			switch c.Status {
+			case "FAIL", "ERROR":
+			case "WARN":
+				if !withWarnings {
+					continue
+				}
+			default:
+				continue
+			}
+			out = append(out, Issue{File: f.Path, Check: c.Name, Code: c.Code, Status: c.Status, Message: c.Message})
+		}
+	}
+	return out
+}
+
+// Compare matches issues by file path and rule code, so a renamed check is
+// still the same issue. Reports written before checks had codes are
+// matched by check name instead. An issue present in both reports is
+// unchanged (reported with its current status and message).
+func Compare(base, head Report, withWarnings bool) Diff {
+	baseIssues, headIssues := base.Issues(withWarnings), head.Issues(withWarnings)
+	byCode := hasCodes(baseIssues) && hasCodes(headIssues)
+
+	type key struct{ file, rule string }
+	index := func(is []Issue) map[key]Issue {
+		m := make(map[key]Issue, len(is))
+		for _, i := range is {
+			rule := i.Check
+			if byCode && i.Code != "" {
+				rule = i.Code
+			}
+			m[key{i.File, rule}] = i
+		}
+		return m
+	}
+	before := index(baseIssues)
+	after := index(headIssues)
+
+	var d Diff
+	for k, i := range after {
+		if _, ok := before[k]; ok {
+			d.Unchanged = append(d.Unchanged, i)
+		} else {
+			d.Introduced = append(d.Introduced, i)
+		}
+	}
+	for k, i := range before {
+		if _, ok := after[k]; !ok {
+			d.Resolved = append(d.Resolved, i)
+		}
+	}
+	for _, s := range [][]Issue{d.Introduced, d.Resolved, d.Unchanged} {
+		sort.Slice(s, func(a, b int) bool {
+			if s[a].File != s[b].File {
+				return s[a].File < s[b].File
+			}
+			return s[a].Check < s[b].Check
+		})
+	}
+	return d
+}
+
+// hasCodes reports whether every check issue carries a rule code;
+// operational errors never do.
+func hasCodes(is []Issue) bool {
+	for _, i := range is {
+		if i.Code == "" && i.Check != OpErrorRule {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func rep(files ...File) Report { return Report{SchemaVersion: SchemaVersion, Files: files} }
+
+func withChecks(path string, cs ...Check) File {
+	return File{Path: path, Summary: &Summary{Checks: cs}}
+}
+
+func keys(is []Issue) string {
+	out := make([]string, len(is))
+	for i, x := range is {
+		out[i] = x.File + ":" + x.Check
+	}
+	return strings.Join(out, ",")
+}
+
+func TestCompare(t *testing.T) {
+	base := rep(
+		withChecks("a.csv",
+			Check{Name: "c1", Status: "FAIL"},
+			Check{Name: "c2", Status: "FAIL"},
+			Check{Name: "w", Status: "WARN"},
+		),
+		File{Path: "gone.csv", OpError: "no such file"},
+	)
+	head := rep(
+		withChecks("a.csv",
+			Check{Name: "c1", Status: "ERROR", Message: "now"},
+			Check{Name: "c2", Status: "PASS"},
+			Check{Name: "c3", Status: "FAIL"},
+		),
+		withChecks("b.csv", Check{Name: "w", Status: "WARN"}),
+	)
+
+	d := Compare(base, head, false)
+	if got := keys(d.Introduced); got != "a.csv:c3" {
+		t.Errorf("introduced: %s", got)
+	}
+	if got := keys(d.Resolved); got != "a.csv:c2,gone.csv:operational-error" {
+		t.Errorf("resolved: %s", got)
+	}
+	if got := keys(d.Unchanged); got != "a.csv:c1" || d.Unchanged[0].Status != "ERROR" {
+		t.Errorf("unchanged: %s %+v", got, d.Unchanged)
+	}
+
+	d = Compare(base, head, true)
+	if got := keys(d.Introduced); got != "a.csv:c3,b.csv:w" {
+		t.Errorf("introduced with warnings: %s", got)
+	}
+	if got := keys(d.Resolved); got != "a.csv:c2,a.csv:w,gone.csv:operational-error" {
+		t.Errorf("resolved with warnings: %s", got)
+	}
+}
+
+func TestCompare_MatchesRenamedChecksByCode(t *testing.T) {
+	base := rep(withChecks("a.csv", Check{Name: "old-name", Code: "GG-X", Status: "FAIL"}))
+	head := rep(withChecks("a.csv", Check{Name: "new-name", Code: "GG-X", Status: "FAIL"}))
+	if d := Compare(base, head, false); len(d.Introduced) != 0 || keys(d.Unchanged) != "a.csv:new-name" {
+		t.Errorf("renamed check should be unchanged: %+v", d)
+	}
+
+	// a base report without codes falls back to names on both sides
+	base = rep(withChecks("a.csv", Check{Name: "new-name", Status: "FAIL"}))
+	if d := Compare(base, head, false); len(d.Introduced) != 0 || len(d.Unchanged) != 1 {
+		t.Errorf("name fallback failed: %+v", d)
+	}
+}
+
+func TestRead_RejectsOtherSchemaVersion(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "r.json")
+	if err := os.WriteFile(p, []byte(`{"schema_version": 99, "files": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(p); err == nil || !strings.Contains(err.Error(), "schema_version 99") {
+		t.Fatalf("expected schema version error, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package report
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+)
+
+type junitSuite struct {
+	XMLName  xml.Name    `xml:"testsuite"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitProblem `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitWriter streams a JUnit XML document with one <testsuite> per file.
+// Root-level totals are omitted since they are unknown until the end.
+type JUnitWriter struct {
+	w   *bufio.Writer
+	enc *xml.Encoder
+	err error
+}
+
+// NewJUnitWriter writes the XML prolog and opens <testsuites>.
+func NewJUnitWriter(w io.Writer) *JUnitWriter {
+	bw := bufio.NewWriter(w)
+	jw := &JUnitWriter{w: bw, enc: xml.NewEncoder(bw)}
+	jw.enc.Indent("  ", "  ")
+	_, jw.err = bw.WriteString(xml.Header + `<testsuites name="glossary-guard">` + "\n")
+	return jw
+}
+
+// WriteFile encodes f as a test suite where every check is a test case.
+// WARN results pass and carry their message in system-out; checks skipped
+// after an early exit are reported as skipped test cases.
+func (jw *JUnitWriter) WriteFile(f File) error {
+	if jw.err != nil {
+		return jw.err
+	}
+	suite := junitSuite{Name: f.Path}
+	if f.OpError != "" {
+		suite.Cases = append(suite.Cases, junitCase{
+			ClassName: f.Path,
+			Name:      OpErrorRule,
+			Error:     &junitProblem{Message: f.OpError, Text: f.OpError},
+		})
+		suite.Errors++
+	}
+	if f.Summary != nil {
+		for _, c := range f.Summary.Checks {
+			tc := junitCase{ClassName: f.Path, Name: c.Name}
+			switch c.Status {
+			case "FAIL":
+				tc.Failure = &junitProblem{Message: c.Message, Text: c.Message}
+				suite.Failures++
+			case "ERROR":
+				tc.Error = &junitProblem{Message: c.Message, Text: c.Message}
+				suite.Errors++
+			case "WARN":
+				tc.SystemOut = "WARN: " + c.Message
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+		for _, name := range f.Summary.Skipped {
+			msg := "not run: stopped early at " + f.Summary.EarlyCheck
+			suite.Cases = append(suite.Cases, junitCase{
+				ClassName: f.Path,
+				Name:      name,
+				Skipped:   &junitProblem{Message: msg},
+			})
+			suite.Skipped++
+		}
+	}
+	suite.Tests = len(suite.Cases)
+
+	if jw.err = jw.enc.Encode(suite); jw.err != nil {
+		return jw.err
+	}
+	_, jw.err = jw.w.WriteString("\n")
+	return jw.err
+}
+
+// Close ends <testsuites> and flushes.
+func (jw *JUnitWriter) Close() error {
+	if jw.err != nil {
+		return jw.err
+	}
+	if _, err := jw.w.WriteString("</testsuites>\n"); err != nil {
+		return err
+	}
+	return jw.w.Flush()
+}
@@ -0,0 +1,124 @@
+// Package report defines the stable, versioned JSON report emitted by
+// "validate --json". Bump SchemaVersion and schema.json on any breaking change.
+package report
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+// SchemaVersion is written into every JSON report.
+const SchemaVersion = 1
+
+// Schema is the JSON Schema document describing Report.
+//
+//go:embed schema.json
+var Schema []byte
+
+// Overall results of a run, from worst to best.
+const (
+	ResultError  = "error"  // a file could not be validated
+	ResultFailed = "failed" // a file failed validation
+	ResultFixed  = "fixed"  // everything passes after fixes, but files were modified
+	ResultPassed = "passed"
+)
+
+// Report is the top-level JSON document.
+type Report struct {
+	SchemaVersion int `json:"schema_version"`
+	// Result is one of the Result constants.
+	Result string `json:"result,omitempty"`
+	Files  []File `json:"files"`
+}
+
+// File is the outcome for one input file.
+type File struct {
+	Path       string   `json:"path"`
+	Passed     int      `json:"passed"`
+	Warned     int      `json:"warned"`
+	Failed     int      `json:"failed"`
+	Errored    int      `json:"errored"`
+	HadOpErr   bool     `json:"had_op_err"`
+	HadValFail bool     `json:"had_val_fail"`
+	Modified   bool     `json:"modified"` // fixes changed the file (or would, in a dry run)
+	OpError    string   `json:"op_error,omitempty"`
+	DurationMS float64  `json:"duration_ms"`
+	Summary    *Summary `json:"summary,omitempty"`
+	Stats      *Stats   `json:"stats,omitempty"`
+}
+
+// Summary mirrors validator.Summary without the raw file bytes.
+type Summary struct {
+	Pass         int     `json:"pass"`
+	Warn         int     `json:"warn"`
+	Fail         int     `json:"fail"`
+	Error        int     `json:"error"`
+	EarlyExit    bool    `json:"early_exit"`
+	EarlyCheck   string  `json:"early_check,omitempty"`
+	EarlyStatus  string  `json:"early_status,omitempty"`
+	AppliedFixes bool    `json:"applied_fixes"`
+	FinalPath    string  `json:"final_path"`
+	Checks       []Check `json:"checks"`
+	// Skipped names the checks that did not run after an early exit.
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// Check is a single check outcome in execution order.
+type Check struct {
+	Name    string `json:"name"`
+	Code    string `json:"code,omitempty"` // stable rule id, e.g. GG-UTF8
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Changed bool   `json:"changed"`
+	Note    string `json:"note,omitempty"`
+	// DurationMS is the check's wall time in milliseconds.
+	DurationMS float64 `json:"duration_ms"`
+	// Findings are the individual problems of a non-passing check.
+	Findings []findings.Finding `json:"findings,omitempty"`
+}
+
+// Stats holds per-column statistics (validate --stats).
+type Stats struct {
+	Rows    int           `json:"rows"`
+	Columns []ColumnStats `json:"columns"`
+}
+
+// ColumnStats describes one header column. Lengths are in runes over non-empty cells.
+type ColumnStats struct {
+	Name     string         `json:"name"`
+	NonEmpty int            `json:"non_empty"`
+	MinLen   int            `json:"min_len"`
+	MaxLen   int            `json:"max_len"`
+	AvgLen   float64        `json:"avg_len"`
+	Values   map[string]int `json:"values,omitempty"`
+}
+
+// NewSummary converts a core validator summary into its report form.
+func NewSummary(s validator.Summary) *Summary {
+	out := &Summary{
+		Pass:         s.Pass,
+		Warn:         s.Warn,
+		Fail:         s.Fail,
+		Error:        s.Error,
+		EarlyExit:    s.EarlyExit,
+		EarlyCheck:   s.EarlyCheck,
+		EarlyStatus:  string(s.EarlyStatus),
+		AppliedFixes: s.AppliedFixes,
+		FinalPath:    s.FinalPath,
+		Checks:       make([]Check, 0, len(s.Outcomes)),
+	}
+	for _, o := range s.Outcomes {
+		out.Checks = append(out.Checks, Check{
+			Name:    o.Result.Name,
+			Status:  string(o.Result.Status),
+			Message: strings.TrimSpace(o.Result.Message),
+			Changed: o.Final.DidChange,
+			Note:    strings.TrimSpace(o.Final.Note),
+		})
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package report
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestSchemaMatchesVersion(t *testing.T) {
+	var doc struct {
+		Properties struct {
+			SchemaVersion struct {
+				Const int `json:"const"`
+			} `json:"schema_version"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(Schema, &doc); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+	if doc.Properties.SchemaVersion.Const != SchemaVersion {
+		t.Fatalf("schema pins version %d, code writes %d", doc.Properties.SchemaVersion.Const, SchemaVersion)
+	}
+}
+
+func TestNewSummary(t *testing.T) {
+	s := NewSummary(validator.Summary{
+		Warn:      1,
+		FinalData: []byte("not exported"),
+		FinalPath: "g.csv",
+		Outcomes: []checks.CheckOutcome{{
+			Result: checks.CheckResult{Name: "c", Status: checks.Warn, Message: " msg \n"},
+			Final:  checks.FixResult{DidChange: true, Note: "fixed"},
+		}},
+	})
+	raw, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"pass":0,"warn":1,"fail":0,"error":0,"early_exit":false,"applied_fixes":false,"final_path":"g.csv",` +
+		`"checks":[{"name":"c","status":"WARN","message":"msg","changed":true,"note":"fixed","duration_ms":0}]}`
+	if string(raw) != want {
+		t.Fatalf("got  %s\nwant %s", raw, want)
+	}
+}
@@ -0,0 +1,195 @@
+package report
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolURI      = "https://github.com/bodrovis/lokalise-glossary-guard"
+
+	// OpErrorRule is the rule id used for files that could not be read or written.
+	OpErrorRule = "operational-error"
+)
+
+// Rule describes a check for the SARIF driver section. ID is the stable
+// rule code when the check has one, else its name.
+type Rule struct {
+	ID       string
+	Name     string // check name, optional
+	FailFast bool
+	Help     string // remediation hint, optional
+	HelpURI  string // documentation link, optional
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	ShortDescription sarifMessage  `json:"shortDescription"`
+	Help             *sarifMessage `json:"help,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+	Properties       struct {
+		FailFast bool `json:"failFast"`
+	} `json:"properties"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region *sarifRegion `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifRegion struct {
+	StartLine  int   `json:"startLine"`
+	ByteOffset int64 `json:"byteOffset"`
+}
+
+// SARIFWriter streams a SARIF 2.1.0 log: the envelope is written up front,
+// results are appended per file and the document is closed by Close.
+type SARIFWriter struct {
+	w       *bufio.Writer
+	started bool
+	first   bool
+	err     error
+}
+
+// NewSARIFWriter writes the log header including the rule table.
+func NewSARIFWriter(w io.Writer, version string, rules []Rule) *SARIFWriter {
+	sw := &SARIFWriter{w: bufio.NewWriter(w), first: true}
+
+	driverRules := make([]sarifRule, 0, len(rules))
+	for _, r := range rules {
+		sr := sarifRule{ID: r.ID, Name: r.Name, ShortDescription: sarifMessage{Text: r.ID}}
+		if r.Name != "" {
+			sr.ShortDescription.Text = r.Name
+		}
+		sr.Properties.FailFast = r.FailFast
+		if r.Help != "" {
+			sr.Help = &sarifMessage{Text: r.Help}
+		}
+		sr.HelpURI = r.HelpURI
+		driverRules = append(driverRules, sr)
+	}
+	driver := map[string]any{
+		"name":           "glossary-guard",
+		"version":        version,
+		"informationUri": toolURI,
+		"rules":          driverRules,
+	}
+	driverJSON, err := json.Marshal(driver)
+	if err != nil {
+		sw.err = err
+		return sw
+	}
+
+	sw.write(`{"version":"` + sarifVersion + `","$schema":"` + sarifSchema + `","runs":[{"tool":{"driver":`)
+	sw.write(string(driverJSON))
+	sw.write(`},"results":[`)
+	sw.started = true
+	return sw
+}
+
+// WriteFile appends one result per finding of each non-passing check of f,
+// or one per check when it has no findings.
+func (sw *SARIFWriter) WriteFile(f File) error {
+	if f.OpError != "" {
+		sw.writeResult(sarifResultFor(f.Path, OpErrorRule, "ERROR", f.OpError, nil))
+	}
+	if f.Summary != nil {
+		for _, c := range f.Summary.Checks {
+			if c.Status == "PASS" {
+				continue
+			}
+			rule := c.Code
+			if rule == "" {
+				rule = c.Name
+			}
+			if len(c.Findings) == 0 {
+				sw.writeResult(sarifResultFor(f.Path, rule, c.Status, c.Message, nil))
+				continue
+			}
+			for _, fd := range c.Findings {
+				msg := fd.Message
+				if msg == "" {
+					msg = c.Message
+				}
+				sw.writeResult(sarifResultFor(f.Path, rule, string(fd.Severity), msg, &fd.Location))
+			}
+		}
+	}
+	return sw.err
+}
+
+// Close terminates the JSON document and flushes.
+func (sw *SARIFWriter) Close() error {
+	if sw.started {
+		sw.write("]}]}\n")
+	}
+	if sw.err != nil {
+		return sw.err
+	}
+	return sw.w.Flush()
+}
+
+func (sw *SARIFWriter) writeResult(r sarifResult) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		sw.err = err
+		return
+	}
+	if !sw.first {
+		sw.write(",")
+	}
+	sw.first = false
+	sw.write(string(raw))
+}
+
+func (sw *SARIFWriter) write(s string) {
+	if sw.err != nil {
+		return
+	}
+	_, sw.err = sw.w.WriteString(s)
+}
+
+// sarifResultFor builds a result pointing at l, or at the file as a whole
+// when l is nil.
+func sarifResultFor(path, rule, status, msg string, l *findings.Location) sarifResult {
+	r := sarifResult{RuleID: rule, Level: sarifLevel(status), Message: sarifMessage{Text: msg}}
+	var loc sarifLocation
+	loc.PhysicalLocation.ArtifactLocation.URI = path
+	if l != nil {
+		loc.PhysicalLocation.Region = &sarifRegion{StartLine: l.Row, ByteOffset: l.Offset}
+	}
+	r.Locations = []sarifLocation{loc}
+	return r
+}
+
+func sarifLevel(status string) string {
+	switch status {
+	case "WARN":
+		return "warning"
+	case "FAIL", "ERROR":
+		return "error"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,111 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func sampleFiles() []File {
+	return []File{
+		{Path: "a.csv", Summary: &Summary{Checks: []Check{
+			{Name: "c1", Status: "PASS", Message: "ok"},
+			{Name: "c2", Status: "WARN", Message: "careful"},
+			{Name: "c3", Code: "GG-C3", Status: "FAIL", Message: "broken <row 2>"},
+		}, EarlyExit: true, EarlyCheck: "c3", Skipped: []string{"c4"}}},
+		{Path: "b.csv", OpError: "open b.csv: no such file"},
+	}
+}
+
+func TestSARIFWriter(t *testing.T) {
+	var buf bytes.Buffer
+	sw := NewSARIFWriter(&buf, "1.2.3", []Rule{{ID: "c1"}, {ID: "GG-C3", Name: "c3", FailFast: true, Help: "fix c3", HelpURI: "https://docs/c3"}})
+	for _, f := range sampleFiles() {
+		if err := sw.WriteFile(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Version string `json:"version"`
+					Rules   []struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+						Help *struct {
+							Text string `json:"text"`
+						} `json:"help"`
+						HelpURI string `json:"helpUri"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID string `json:"ruleId"`
+				Level  string `json:"level"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v\n%s", err, buf.String())
+	}
+	run := doc.Runs[0]
+	if doc.Version != "2.1.0" || run.Tool.Driver.Version != "1.2.3" || len(run.Tool.Driver.Rules) != 2 {
+		t.Fatalf("unexpected header: %+v", doc)
+	}
+	if r := run.Tool.Driver.Rules[0]; r.Help != nil || r.HelpURI != "" {
+		t.Errorf("rule without remediation should omit help: %+v", r)
+	}
+	if r := run.Tool.Driver.Rules[1]; r.Name != "c3" || r.Help == nil || r.Help.Text != "fix c3" || r.HelpURI != "https://docs/c3" {
+		t.Errorf("unexpected help for c3: %+v", r)
+	}
+	got := []string{}
+	for _, r := range run.Results {
+		got = append(got, r.RuleID+":"+r.Level)
+	}
+	if strings.Join(got, ",") != "c2:warning,GG-C3:error,operational-error:error" {
+		t.Fatalf("unexpected results: %v", got)
+	}
+}
+
+func TestJUnitWriter(t *testing.T) {
+	var buf bytes.Buffer
+	jw := NewJUnitWriter(&buf)
+	for _, f := range sampleFiles() {
+		if err := jw.WriteFile(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := jw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Suites []struct {
+			Name     string `xml:"name,attr"`
+			Tests    int    `xml:"tests,attr"`
+			Failures int    `xml:"failures,attr"`
+			Errors   int    `xml:"errors,attr"`
+			Skipped  int    `xml:"skipped,attr"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JUnit XML: %v\n%s", err, buf.String())
+	}
+	if len(doc.Suites) != 2 {
+		t.Fatalf("got %d suites, want 2", len(doc.Suites))
+	}
+	if s := doc.Suites[0]; s.Name != "a.csv" || s.Tests != 4 || s.Failures != 1 || s.Errors != 0 || s.Skipped != 1 {
+		t.Fatalf("unexpected suite: %+v", s)
+	}
+	if s := doc.Suites[1]; s.Tests != 1 || s.Errors != 1 {
+		t.Fatalf("unexpected op-error suite: %+v", s)
+	}
+}
@@ -0,0 +1,179 @@
+// Package resources reads translation resource files (JSON, YAML and Apple
+// .strings) as key/value entries, for commands that compare a glossary with
+// the strings of a product.
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/unicode"
+	"gopkg.in/yaml.v3"
+)
+
+// Entry is a string of a resource file. Nested keys are joined with dots
+// ("auth.login.title"), list items by their index.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// readers parse a file into entries, keyed by extension.
+var readers = map[string]func([]byte) ([]Entry, error){
+	".json":    readJSON,
+	".yaml":    readYAML,
+	".yml":     readYAML,
+	".strings": readAppleStrings,
+}
+
+// Extensions lists the supported file extensions for messages.
+const Extensions = ".json, .yaml, .yml, .strings"
+
+// Supported reports whether path has a supported extension.
+func Supported(path string) bool {
+	_, ok := readers[strings.ToLower(filepath.Ext(path))]
+	return ok
+}
+
+// Find lists the supported files under dirs whose base name matches
+// pattern, sorted.
+func Find(dirs []string, pattern string) ([]string, error) {
+	var out []string
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !Supported(p) {
+				return nil
+			}
+			if ok, _ := filepath.Match(pattern, d.Name()); ok {
+				out = append(out, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// Read returns the entries of the resource file at path, sorted by key.
+// Keys are identifiers, so only values are copy.
+func Read(path string, data []byte) ([]Entry, error) {
+	fn := readers[strings.ToLower(filepath.Ext(path))]
+	if fn == nil {
+		return nil, fmt.Errorf("%s: unsupported file type", path)
+	}
+	es, err := fn(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	sort.SliceStable(es, func(i, j int) bool { return es[i].Key < es[j].Key })
+	return es, nil
+}
+
+func readJSON(data []byte) ([]Entry, error) {
+	var v any
+	if err := json.Unmarshal(bytes.TrimPrefix(data, []byte("\ufeff")), &v); err != nil {
+		return nil, err
+	}
+	return flatten("", stripLocaleRoot(v), nil), nil
+}
+
+func readYAML(data []byte) ([]Entry, error) {
+	var out []Entry
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			if errors.Is(err, io.EOF) {
+				return out, nil
+			}
+			return nil, err
+		}
+		out = flatten("", stripLocaleRoot(v), out)
+	}
+}
+
+var localeRe = regexp.MustCompile(`^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*$`)
+
+// stripLocaleRoot drops a single top-level locale key (as in Rails files,
+// "en:" or "de:"), so the same string has the same key in every language.
+func stripLocaleRoot(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) != 1 {
+		return v
+	}
+	for k, inner := range m {
+		if _, isMap := inner.(map[string]any); isMap && localeRe.MatchString(k) {
+			return inner
+		}
+	}
+	return v
+}
+
+func flatten(prefix string, v any, out []Entry) []Entry {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+	switch x := v.(type) {
+	case string:
+		out = append(out, Entry{Key: prefix, Value: x})
+	case []any:
+		for i, e := range x {
+			out = flatten(join(strconv.Itoa(i)), e, out)
+		}
+	case map[string]any:
+		for k, e := range x {
+			out = flatten(join(k), e, out)
+		}
+	}
+	return out
+}
+
+// stringsEntry matches "key" = "value"; in Apple .strings files, and
+// stringsComment the comments around entries.
+var (
+	stringsEntry   = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"\s*=\s*"((?:[^"\\]|\\.)*)"\s*;`)
+	stringsComment = regexp.MustCompile(`(?s:/\*.*?\*/)|(?m:^[ \t]*//[^\n]*$)`)
+)
+
+// readAppleStrings reads an Apple .strings file, which Xcode often saves
+// as UTF-16.
+func readAppleStrings(data []byte) ([]Entry, error) {
+	if bytes.HasPrefix(data, []byte{0xff, 0xfe}) || bytes.HasPrefix(data, []byte{0xfe, 0xff}) {
+		dec := unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM).NewDecoder()
+		var err error
+		if data, err = dec.Bytes(data); err != nil {
+			return nil, err
+		}
+	}
+	data = stringsComment.ReplaceAll(data, nil)
+	var out []Entry
+	for _, m := range stringsEntry.FindAllSubmatch(data, -1) {
+		out = append(out, Entry{Key: unquote(m[1]), Value: unquote(m[2])})
+	}
+	return out, nil
+}
+
+func unquote(b []byte) string {
+	if s, err := strconv.Unquote(`"` + string(b) + `"`); err == nil {
+		return s
+	}
+	return string(b)
+}
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	cases := map[string]struct {
+		data string
+		want []Entry
+	}{
+		"en.json": {
+			`{"auth": {"login": "Log in", "hints": ["Forgot password?"]}, "count": 3}`,
+			[]Entry{{"auth.hints.0", "Forgot password?"}, {"auth.login", "Log in"}},
+		},
+		"en.yml": {
+			"en:\n  save: Save file\n  nested:\n    - Open project\n",
+			[]Entry{{"nested.0", "Open project"}, {"save", "Save file"}},
+		},
+		"Localizable.strings": {
+			"/* \"Ignored\" = \"comment\"; */\n// \"also\" = \"ignored\";\n\"save\" = \"Save \\\"draft\\\"\";\n\"url\" = \"https://example.com\";\n",
+			[]Entry{{"save", "Save \"draft\""}, {"url", "https://example.com"}},
+		},
+	}
+	for name, tc := range cases {
+		got, err := Read(name, []byte(tc.data))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %q, want %q", name, got, tc.want)
+		}
+	}
+	if _, err := Read("x.po", nil); err == nil {
+		t.Error("expected an error for an unsupported file")
+	}
+}
+
+func TestReadUTF16Strings(t *testing.T) {
+	// "a" = "Büro"; in UTF-16LE with a BOM, as Xcode writes it.
+	data := []byte{0xff, 0xfe}
+	for _, r := range `"a" = "Büro";` {
+		data = append(data, byte(r), byte(r>>8))
+	}
+	got, err := Read("x.strings", data)
+	if err != nil || len(got) != 1 || got[0].Value != "Büro" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	for _, p := range []string{"en.json", "de.json", "ios/en.lproj/Localizable.strings", "README.md"} {
+		full := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte("{}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := Find([]string{dir}, "*")
+	if err != nil || len(got) != 3 {
+		t.Fatalf("Find(*) = %q, %v", got, err)
+	}
+	got, err = Find([]string{dir}, "en.*")
+	if err != nil || len(got) != 1 || filepath.Base(got[0]) != "en.json" {
+		t.Fatalf("Find(en.*) = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,229 @@
+// Package undo keeps a journal of the files the last fix run wrote, with
+// the hashes of their content before and after and copies of what they
+// replaced, so that "undo-fix" can put every file back in one step.
+package undo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard/internal/fsutil"
+)
+
+// DefaultDir holds the journal, relative to the working directory.
+const DefaultDir = ".glossaryguard-undo"
+
+const journalFile = "journal.json"
+
+// ErrNoJournal is returned by Load when no fix run has been recorded.
+var ErrNoJournal = errors.New("no fix run to undo")
+
+// ErrChanged is returned by Restore when the file no longer holds what the
+// fix run wrote.
+var ErrChanged = errors.New("changed since the fix run")
+
+// Journal lists the files one fix run wrote.
+type Journal struct {
+	SchemaVersion int       `json:"schema_version"`
+	ID            string    `json:"id"` // names the directory of the copies
+	Time          time.Time `json:"time"`
+	Entries       []Entry   `json:"entries"`
+}
+
+// Entry is one file written by a fix run. Paths are absolute.
+type Entry struct {
+	Path string `json:"path"`
+	// Before holds the content Path had before the run, either a copy in
+	// the journal directory or the --in-place backup. It is empty when the
+	// run created Path.
+	Before       string `json:"before,omitempty"`
+	BeforeSHA256 string `json:"before_sha256,omitempty"`
+	AfterSHA256  string `json:"after_sha256"`
+}
+
+// Run records the writes of a fix run into dir. Nothing is touched until
+// the first write is recorded; from then on the journal of the previous
+// run is gone. Safe for concurrent use.
+type Run struct {
+	dir string
+
+	mu      sync.Mutex
+	journal Journal
+}
+
+// Begin starts recording a run into dir.
+func Begin(dir string) *Run {
+	now := time.Now()
+	return &Run{
+		dir: dir,
+		journal: Journal{
+			SchemaVersion: 1,
+			ID:            now.Format("20060102-150405") + "-" + strconv.Itoa(os.Getpid()),
+			Time:          now.UTC(),
+		},
+	}
+}
+
+// Continue adds to the journal in dir, so that a resumed run and the run it
+// resumes are undone together. Without a journal it is Begin.
+func Continue(dir string) (*Run, error) {
+	j, err := Load(dir)
+	if errors.Is(err, ErrNoJournal) {
+		return Begin(dir), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Run{dir: dir, journal: *j}, nil
+}
+
+// Record notes that after is about to be written to path, saving what path
+// holds now. Call it before writing, so a crash leaves something to undo.
+func (r *Run) Record(path string, after []byte) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	before, err := os.ReadFile(abs)
+	existed := err == nil
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.start(); err != nil {
+		return err
+	}
+	e := Entry{Path: abs, AfterSHA256: digest(after)}
+	if existed {
+		e.Before = filepath.Join(r.runDir(), "copy-"+strconv.Itoa(len(r.journal.Entries)))
+		e.BeforeSHA256 = digest(before)
+		if err := fsutil.WriteFile(e.Before, before, 0o600); err != nil {
+			return err
+		}
+	}
+	return r.add(e)
+}
+
+// RecordBackup notes that path was replaced by after once before had been
+// saved to backup, as --in-place does.
+func (r *Run) RecordBackup(path, backup string, before, after []byte) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	bak, err := filepath.Abs(backup)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.start(); err != nil {
+		return err
+	}
+	return r.add(Entry{Path: abs, Before: bak, BeforeSHA256: digest(before), AfterSHA256: digest(after)})
+}
+
+func (r *Run) runDir() string {
+	return filepath.Join(r.dir, "runs", r.journal.ID)
+}
+
+// start drops the previous run on the first record.
+func (r *Run) start() error {
+	if len(r.journal.Entries) > 0 {
+		return nil
+	}
+	if err := os.RemoveAll(filepath.Join(r.dir, "runs")); err != nil {
+		return err
+	}
+	return os.MkdirAll(r.runDir(), 0o755)
+}
+
+func (r *Run) add(e Entry) error {
+	r.journal.Entries = append(r.journal.Entries, e)
+	return Save(r.dir, &r.journal)
+}
+
+// Load reads the journal in dir.
+func Load(dir string) (*Journal, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, journalFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNoJournal
+	}
+	if err != nil {
+		return nil, err
+	}
+	var j Journal
+	if err := json.Unmarshal(raw, &j); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", filepath.Join(dir, journalFile), err)
+	}
+	if len(j.Entries) == 0 {
+		return nil, ErrNoJournal
+	}
+	return &j, nil
+}
+
+// Save writes j into dir, replacing the journal there.
+func Save(dir string, j *Journal) error {
+	data, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFile(filepath.Join(dir, journalFile), append(data, '\n'), 0o644)
+}
+
+// Clear removes the journal in dir and the copies it kept.
+func Clear(dir string) error {
+	return errors.Join(
+		os.Remove(filepath.Join(dir, journalFile)),
+		os.RemoveAll(filepath.Join(dir, "runs")),
+	)
+}
+
+// Restore puts the content e.Path had before the run back, or removes the
+// file when the run created it. Unless force is set, a file that no longer
+// holds what the run wrote is left alone and ErrChanged is returned.
+func (e Entry) Restore(force bool) error {
+	cur, err := os.ReadFile(e.Path)
+	exists := err == nil
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if !force && (!exists || digest(cur) != e.AfterSHA256) {
+		return ErrChanged
+	}
+
+	if e.Before == "" {
+		if !exists {
+			return nil
+		}
+		return os.Remove(e.Path)
+	}
+	before, err := os.ReadFile(e.Before)
+	if err != nil {
+		return fmt.Errorf("read saved content: %w", err)
+	}
+	if digest(before) != e.BeforeSHA256 {
+		return fmt.Errorf("saved content %s does not match its recorded hash", e.Before)
+	}
+	perm := fs.FileMode(0o644)
+	if fi, err := os.Stat(e.Path); err == nil {
+		perm = fi.Mode().Perm()
+	}
+	return fsutil.WriteFile(e.Path, before, perm)
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
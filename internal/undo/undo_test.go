@@ -0,0 +1,121 @@
+package undo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordAndRestore(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, DefaultDir)
+	created := filepath.Join(tmp, "g_fixed.csv")
+	replaced := filepath.Join(tmp, "h_fixed.csv")
+	inPlace := filepath.Join(tmp, "i.csv")
+	bak := inPlace + ".bak"
+	for p, s := range map[string]string{replaced: "old h", inPlace: "fixed i", bak: "old i"} {
+		if err := os.WriteFile(p, []byte(s), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := Begin(dir)
+	for p, s := range map[string]string{created: "new g", replaced: "new h"} {
+		if err := r.Record(p, []byte(s)); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(s), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := r.RecordBackup(inPlace, bak, []byte("old i"), []byte("fixed i")); err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(j.Entries) != 3 {
+		t.Fatalf("entries = %+v", j.Entries)
+	}
+	for _, e := range j.Entries {
+		if err := e.Restore(false); err != nil {
+			t.Fatalf("%s: %v", e.Path, err)
+		}
+	}
+	if _, err := os.Stat(created); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("created file not removed: %v", err)
+	}
+	for p, want := range map[string]string{replaced: "old h", inPlace: "old i"} {
+		if got, _ := os.ReadFile(p); string(got) != want {
+			t.Errorf("%s = %q, want %q", p, got, want)
+		}
+	}
+
+	if err := Clear(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(dir); !errors.Is(err, ErrNoJournal) {
+		t.Fatalf("Load after Clear: %v", err)
+	}
+}
+
+func TestRestoreLeavesEditedFiles(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, DefaultDir)
+	p := filepath.Join(tmp, "g_fixed.csv")
+	if err := os.WriteFile(p, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r := Begin(dir)
+	if err := r.Record(p, []byte("fixed")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte("edited by hand"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Entries[0].Restore(false); !errors.Is(err, ErrChanged) {
+		t.Fatalf("Restore = %v, want ErrChanged", err)
+	}
+	if err := j.Entries[0].Restore(true); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(p); string(got) != "old" {
+		t.Fatalf("file = %q", got)
+	}
+
+	// A new run replaces the journal of the previous one.
+	r = Begin(dir)
+	if err := r.Record(filepath.Join(tmp, "other.csv"), []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if j, err := Load(dir); err != nil || len(j.Entries) != 1 || j.Entries[0].Before != "" {
+		t.Fatalf("journal = %+v, %v", j, err)
+	}
+}
+
+func TestContinue(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, DefaultDir)
+	r := Begin(dir)
+	if err := r.Record(filepath.Join(tmp, "a.csv"), []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	r, err := Continue(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Record(filepath.Join(tmp, "b.csv"), []byte("b")); err != nil {
+		t.Fatal(err)
+	}
+	if j, err := Load(dir); err != nil || len(j.Entries) != 2 {
+		t.Fatalf("journal = %+v, %v", j, err)
+	}
+}
@@ -0,0 +1,106 @@
+// Package findings carries individual findings (message, severity and
+// location) from checks to reports, so one check can report every bad row
+// instead of a single summary message. Checks in this repository report
+// them through the context they receive; for core checks, which only
+// produce free-text messages, a Locator registered by check name recomputes
+// them.
+package findings
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Location points at a spot in a glossary file.
+type Location struct {
+	Row    int    `json:"row"`              // 1-based line number
+	Column string `json:"column,omitempty"` // header name, if the finding is about a cell or column
+	Offset int64  `json:"offset"`           // byte offset of the start of the row
+}
+
+func (l Location) String() string {
+	if l.Column == "" {
+		return fmt.Sprintf("row %d", l.Row)
+	}
+	return fmt.Sprintf("row %d (%s)", l.Row, l.Column)
+}
+
+// Finding is one problem reported by a check. An empty Severity means the
+// finding shares the status of its check; the runner fills it in.
+type Finding struct {
+	Location
+	Message  string        `json:"message"`
+	Severity checks.Status `json:"severity"`
+}
+
+func (f Finding) String() string {
+	if f.Message == "" {
+		return f.Location.String()
+	}
+	return f.Location.String() + ": " + f.Message
+}
+
+// At is a shorthand for a finding at a cell of a parsed row.
+func At(row int, column string, offset int64, msg string) Finding {
+	return Finding{Location: Location{Row: row, Column: column, Offset: offset}, Message: msg}
+}
+
+// Collector receives the findings reported during one check run.
+type Collector struct {
+	mu  sync.Mutex
+	fds []Finding
+}
+
+type collectorKey struct{}
+
+// WithCollector returns a context that checks can report findings to.
+func WithCollector(ctx context.Context) (context.Context, *Collector) {
+	c := &Collector{}
+	return context.WithValue(ctx, collectorKey{}, c), c
+}
+
+// Report replaces the findings of the running check. Checks call it once
+// per validation pass, so a re-validation after a fix overrides the
+// findings of the first pass. Without a collector it does nothing.
+func Report(ctx context.Context, fds []Finding) {
+	c, _ := ctx.Value(collectorKey{}).(*Collector)
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fds = fds
+}
+
+// Findings returns what the check reported.
+func (c *Collector) Findings() []Finding {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fds
+}
+
+// Locator recomputes findings for a check that does not report them.
+type Locator func(a checks.Artifact) []Finding
+
+var (
+	mu       sync.RWMutex
+	locators = map[string]Locator{}
+)
+
+// RegisterLocator sets the locator for a check name.
+func RegisterLocator(name string, l Locator) {
+	mu.Lock()
+	defer mu.Unlock()
+	locators[name] = l
+}
+
+// LocatorFor returns the locator registered for a check name.
+func LocatorFor(name string) (Locator, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	l, ok := locators[name]
+	return l, ok
+}
@@ -0,0 +1,20 @@
+package findings
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCollector_LastReportWins(t *testing.T) {
+	ctx, c := WithCollector(context.Background())
+	Report(ctx, []Finding{At(2, "", 10, "first"), At(3, "", 20, "second")})
+	Report(ctx, []Finding{At(4, "term", 30, "empty term")})
+
+	got := c.Findings()
+	if len(got) != 1 || got[0].String() != "row 4 (term): empty term" {
+		t.Fatalf("unexpected findings: %+v", got)
+	}
+
+	// no collector: must not panic
+	Report(context.Background(), []Finding{At(1, "", 0, "x")})
+}
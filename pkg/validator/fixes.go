@@ -0,0 +1,32 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// WithFixes returns a copy of units in which only the checks allow accepts
+// may fix; the others run with FixMode FixNone and only validate. Order,
+// priorities and fail-fast flags are unchanged.
+func WithFixes(units []checks.CheckUnit, allow func(name string) bool) []checks.CheckUnit {
+	out := make([]checks.CheckUnit, len(units))
+	for i, u := range units {
+		if allow(u.Name()) {
+			out[i] = u
+			continue
+		}
+		out[i] = validateOnly{u}
+	}
+	return out
+}
+
+// validateOnly runs a check with fixing turned off.
+type validateOnly struct {
+	checks.CheckUnit
+}
+
+func (u validateOnly) Run(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	opts.FixMode = checks.FixNone
+	return u.CheckUnit.Run(ctx, a, opts)
+}
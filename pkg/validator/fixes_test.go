@@ -0,0 +1,32 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestWithFixes(t *testing.T) {
+	var modes []checks.FixMode
+	mk := func(name string) checks.CheckUnit {
+		u, err := checks.NewCheckAdapter(name, func(_ context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			modes = append(modes, opts.FixMode)
+			return checks.CheckOutcome{Result: checks.CheckResult{Name: name, Status: checks.Pass}}
+		}, checks.WithFailFast())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u
+	}
+	units := WithFixes([]checks.CheckUnit{mk("a"), mk("b")}, func(name string) bool { return name == "a" })
+	if names(units) != "a,b" || !units[1].FailFast() {
+		t.Fatalf("units changed: %s", names(units))
+	}
+	for _, u := range units {
+		u.Run(context.Background(), checks.Artifact{}, checks.RunOptions{FixMode: checks.FixIfNotPass})
+	}
+	if modes[0] != checks.FixIfNotPass || modes[1] != checks.FixNone {
+		t.Fatalf("fix modes = %v", modes)
+	}
+}
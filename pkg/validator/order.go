@@ -0,0 +1,116 @@
+package validator
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ReorderNonCritical returns a copy of units where each run of non-critical
+// checks between two fail-fast checks is sorted by score, highest first.
+// Fail-fast checks keep their positions, so every gate still runs after the
+// same set of checks; ties keep the original (priority) order.
+func ReorderNonCritical(units []checks.CheckUnit, score func(name string) float64) []checks.CheckUnit {
+	out := append([]checks.CheckUnit(nil), units...)
+	for start := 0; start < len(out); {
+		if out[start].FailFast() {
+			start++
+			continue
+		}
+		end := start
+		for end < len(out) && !out[end].FailFast() {
+			end++
+		}
+		seg := out[start:end]
+		sort.SliceStable(seg, func(i, j int) bool {
+			return score(seg[i].Name()) > score(seg[j].Name())
+		})
+		start = end
+	}
+	return out
+}
+
+// WithPriorities returns a copy of units sorted as checks.ListSorted sorts
+// them, by priority and then by name, after replacing the priorities of the
+// checks named in prio.
+func WithPriorities(units []checks.CheckUnit, prio map[string]int) []checks.CheckUnit {
+	out := make([]checks.CheckUnit, len(units))
+	for i, u := range units {
+		if p, ok := prio[u.Name()]; ok {
+			u = reprioritized{u, p}
+		}
+		out[i] = u
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		pi, pj := out[i].Priority(), out[j].Priority()
+		if pi != pj {
+			return pi < pj
+		}
+		return strings.ToLower(strings.TrimSpace(out[i].Name())) < strings.ToLower(strings.TrimSpace(out[j].Name()))
+	})
+	return out
+}
+
+// reprioritized reports a configured priority instead of its own.
+type reprioritized struct {
+	checks.CheckUnit
+	priority int
+}
+
+func (u reprioritized) Priority() int { return u.priority }
+
+// Unmet returns the first check of units that runs before one of the checks
+// after names for it. Checks missing from units are ignored.
+func Unmet(units []checks.CheckUnit, after func(name string) []string) (check, dep string, ok bool) {
+	pos := make(map[string]int, len(units))
+	for i, u := range units {
+		pos[u.Name()] = i
+	}
+	for i, u := range units {
+		for _, d := range after(u.Name()) {
+			if j, found := pos[d]; found && j > i {
+				return u.Name(), d, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// KeepAfter returns a copy of units in which every check waits until the
+// checks after names for it have run; the rest keep their order. Checks
+// missing from units are ignored, and a cycle is broken at its first check.
+func KeepAfter(units []checks.CheckUnit, after func(name string) []string) []checks.CheckUnit {
+	if _, _, unmet := Unmet(units, after); !unmet {
+		return units
+	}
+	present := make(map[string]bool, len(units))
+	for _, u := range units {
+		present[u.Name()] = true
+	}
+	done := make(map[string]bool, len(units))
+	ready := func(u checks.CheckUnit) bool {
+		for _, d := range after(u.Name()) {
+			if present[d] && !done[d] && d != u.Name() {
+				return false
+			}
+		}
+		return true
+	}
+
+	pending := append([]checks.CheckUnit(nil), units...)
+	out := make([]checks.CheckUnit, 0, len(units))
+	for len(pending) > 0 {
+		next := 0 // on a cycle, take the first pending check
+		for i, u := range pending {
+			if ready(u) {
+				next = i
+				break
+			}
+		}
+		out = append(out, pending[next])
+		done[pending[next].Name()] = true
+		pending = append(pending[:next], pending[next+1:]...)
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	corevalidator "github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func unit(t *testing.T, name string, failFast bool) checks.CheckUnit {
+	t.Helper()
+	opts := []checks.Option{}
+	if failFast {
+		opts = append(opts, checks.WithFailFast())
+	}
+	u, err := checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.CheckOutcome{Result: checks.CheckResult{Name: name, Status: checks.Pass}}
+	}, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func names(units []checks.CheckUnit) string {
+	out := make([]string, len(units))
+	for i, u := range units {
+		out[i] = u.Name()
+	}
+	return strings.Join(out, ",")
+}
+
+func TestReorderNonCritical(t *testing.T) {
+	units := []checks.CheckUnit{
+		unit(t, "a", false),
+		unit(t, "b", false),
+		unit(t, "GATE1", true),
+		unit(t, "c", false),
+		unit(t, "d", false),
+		unit(t, "e", false),
+		unit(t, "GATE2", true),
+		unit(t, "f", false),
+	}
+	score := map[string]float64{"b": 0.5, "d": 0.2, "e": 0.9, "GATE2": 1}
+
+	got := names(ReorderNonCritical(units, func(n string) float64 { return score[n] }))
+	if want := "b,a,GATE1,e,d,c,GATE2,f"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if names(units) != "a,b,GATE1,c,d,e,GATE2,f" {
+		t.Error("input slice must not be modified")
+	}
+}
+
+func TestValidateAndFixMatchesCore(t *testing.T) {
+	inputs := map[string]string{
+		"valid":    sample,
+		"commas":   "term,description\nAPI,interface\n",
+		"bad-flag": "term;description;casesensitive\nAPI;x;maybe\n",
+		"empty":    "",
+	}
+	opts := []checks.RunOptions{
+		{},
+		{FixMode: checks.FixIfNotPass, RerunAfterFix: true},
+		{HardFailOnErr: true},
+	}
+	for name, in := range inputs {
+		for _, o := range opts {
+			want, werr := corevalidator.Validate(context.Background(), "g.csv", []byte(in), []string{"en"}, o)
+			got, gerr := run(context.Background(), Source{Path: "g.csv", Data: []byte(in), Langs: []string{"en"}}, o, checks.ListSorted())
+
+			if (werr == nil) != (gerr == nil) {
+				t.Errorf("%s %+v: error mismatch: core=%v ours=%v", name, o, werr, gerr)
+			}
+			if got.Pass != want.Pass || got.Warn != want.Warn || got.Fail != want.Fail || got.Error != want.Error ||
+				got.EarlyExit != want.EarlyExit || got.EarlyCheck != want.EarlyCheck ||
+				got.AppliedFixes != want.AppliedFixes || string(got.FinalData) != string(want.FinalData) ||
+				got.FinalPath != want.FinalPath || len(got.Outcomes) != len(want.Outcomes) ||
+				len(got.Outcomes)+len(got.Skipped) != len(checks.ListSorted()) {
+				t.Errorf("%s %+v: summary mismatch:\ncore=%+v\nours=%+v", name, o, want, got)
+			}
+		}
+	}
+}
+
+func TestWithPriorities(t *testing.T) {
+	units := []checks.CheckUnit{unit(t, "a", false), unit(t, "b", false), unit(t, "c", false)}
+	got := WithPriorities(units, map[string]int{"a": 5, "c": -1})
+	if names(got) != "c,b,a" {
+		t.Fatalf("got %s, want c,b,a", names(got))
+	}
+	if got[0].Priority() != -1 || got[2].Priority() != 5 {
+		t.Fatalf("priorities %d, %d not replaced", got[0].Priority(), got[2].Priority())
+	}
+}
+
+func TestKeepAfter(t *testing.T) {
+	units := []checks.CheckUnit{
+		unit(t, "trim", false),
+		unit(t, "a", false),
+		unit(t, "split", false),
+		unit(t, "b", false),
+	}
+	deps := map[string][]string{"trim": {"split", "missing"}}
+	after := func(n string) []string { return deps[n] }
+
+	if check, dep, ok := Unmet(units, after); !ok || check != "trim" || dep != "split" {
+		t.Fatalf("Unmet = %s, %s, %v", check, dep, ok)
+	}
+	got := KeepAfter(units, after)
+	if want := "a,split,trim,b"; names(got) != want {
+		t.Fatalf("got %s, want %s", names(got), want)
+	}
+	if _, _, ok := Unmet(got, after); ok {
+		t.Fatal("dependencies still unmet")
+	}
+
+	// A cycle does not lose checks.
+	deps["split"] = []string{"trim"}
+	if got := KeepAfter(units, after); len(got) != len(units) {
+		t.Fatalf("got %s", names(got))
+	}
+}
@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	corevalidator "github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+// run mirrors the core validator pipeline, but over an explicit unit list so
+// callers control the order: each check sees the output of the previous
+// one, fail-fast checks stop the pipeline on FAIL/ERROR, and HardFailOnErr
+// escalates an ERROR to a returned error. Units that never ran are listed
+// in Report.Skipped; each run is timed into Report.Durations, its findings
+// land in Report.Findings and what it fixed in Report.Fixed.
+func run(ctx context.Context, src Source, opts checks.RunOptions, units []checks.CheckUnit) (Report, error) {
+	r := Report{Summary: corevalidator.Summary{FilePath: src.Path, FinalData: src.Data, FinalPath: src.Path}}
+	s := &r.Summary
+	artifact := checks.Artifact{Data: src.Data, Path: src.Path, Langs: src.Langs}
+
+	skipFrom := func(i int) {
+		for _, u := range units[i:] {
+			r.Skipped = append(r.Skipped, u.Name())
+		}
+	}
+
+	for i, u := range units {
+		if err := ctx.Err(); err != nil {
+			s.EarlyExit = true
+			s.EarlyCheck = "context canceled"
+			s.EarlyStatus = checks.Error
+			skipFrom(i)
+			return r, err
+		}
+
+		uctx, col := findings.WithCollector(ctx)
+		started := time.Now()
+		out := u.Run(uctx, artifact, opts)
+		r.Durations = append(r.Durations, time.Since(started))
+		r.Findings = append(r.Findings, collect(u.Name(), out, artifact, col))
+		count(s, out.Result.Status)
+		s.Outcomes = append(s.Outcomes, out)
+
+		if out.Final.DidChange {
+			s.AppliedFixes = true
+		}
+		if out.Final.Data != nil {
+			artifact.Data = out.Final.Data
+		}
+		if out.Final.Path != "" {
+			artifact.Path = out.Final.Path
+		}
+		s.FinalData, s.FinalPath = artifact.Data, artifact.Path
+		var fixed []byte
+		if out.Final.DidChange {
+			fixed = artifact.Data
+		}
+		r.Fixed = append(r.Fixed, fixed)
+
+		if st := out.Result.Status; u.FailFast() && (st == checks.Fail || st == checks.Error) {
+			s.EarlyExit = true
+			s.EarlyCheck = u.Name()
+			s.EarlyStatus = st
+			skipFrom(i + 1)
+			if st == checks.Error && opts.HardFailOnErr {
+				return r, fmt.Errorf("fail-fast on ERROR at %q: %s", u.Name(), out.Result.Message)
+			}
+			return r, nil
+		}
+	}
+
+	if opts.HardFailOnErr && s.Error > 0 {
+		msg := "one or more checks returned ERROR"
+		for _, o := range s.Outcomes {
+			if o.Result.Status == checks.Error && o.Result.Message != "" {
+				msg = o.Result.Message
+				break
+			}
+		}
+		return r, errors.New(msg)
+	}
+	return r, nil
+}
+
+// collect returns the findings of a non-passing outcome: those the check
+// reported, else those its registered locator finds in the data it saw
+// last. Findings without a severity take the status of the outcome.
+func collect(name string, out checks.CheckOutcome, in checks.Artifact, col *findings.Collector) []findings.Finding {
+	st := out.Result.Status
+	if st == checks.Pass {
+		return nil
+	}
+	fds := col.Findings()
+	if len(fds) == 0 {
+		l, ok := findings.LocatorFor(name)
+		if !ok {
+			return nil
+		}
+		if out.Final.DidChange && out.Final.Data != nil {
+			in.Data = out.Final.Data
+		}
+		fds = l(in)
+	}
+	for i := range fds {
+		if fds[i].Severity == "" {
+			fds[i].Severity = st
+		}
+	}
+	return fds
+}
+
+func count(s *corevalidator.Summary, st checks.Status) {
+	switch st {
+	case checks.Pass:
+		s.Pass++
+	case checks.Warn:
+		s.Warn++
+	case checks.Fail:
+		s.Fail++
+	case checks.Error:
+		s.Error++
+	}
+}
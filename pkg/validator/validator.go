@@ -0,0 +1,99 @@
+// Package validator is the embedding API of the glossary guard. It runs the
+// core checks together with the checks shipped by this tool against an
+// in-memory payload, so services can validate and repair glossaries without
+// touching the disk. The pipeline matches the core validator; it is
+// reimplemented here so callers can choose the order of checks.
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
+	corevalidator "github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/checks/all"
+	_ "github.com/bodrovis/lokalise-glossary-guard/internal/locate"
+	"github.com/bodrovis/lokalise-glossary-guard/pkg/findings"
+)
+
+// Source is a glossary payload to validate.
+type Source struct {
+	// Path is used for extension checks and messages; the file is never read.
+	Path string
+	Data []byte
+	// Langs are the expected language codes (e.g. "en", "de_DE").
+	Langs []string
+}
+
+// Report holds the diagnostics of a run. File contents are stripped from it,
+// except for what each fix produced; the repaired bytes are returned
+// separately as FixedContent.
+type Report struct {
+	corevalidator.Summary
+
+	// Skipped lists, in run order, the checks that never ran because a
+	// fail-fast check or cancellation stopped the pipeline.
+	Skipped []string
+
+	// Durations holds the wall time of each check, aligned with Outcomes.
+	Durations []time.Duration
+
+	// Findings holds the individual problems of each non-passing check,
+	// aligned with Outcomes. Every finding has a severity. Entries are
+	// empty when a check neither reports findings nor has a locator.
+	Findings [][]findings.Finding
+
+	// Fixed holds, aligned with Outcomes, the payload right after each check
+	// that changed it, and nil for the others. Diffing an entry against the
+	// previous non-nil one (or the source) shows what that fix did.
+	Fixed [][]byte
+}
+
+// OK reports whether no check failed or errored. Warnings don't count.
+func (r Report) OK() bool {
+	return r.Fail == 0 && r.Error == 0
+}
+
+// FixedContent is the payload after the fix pipeline. When no fix was
+// applied it echoes the source.
+type FixedContent struct {
+	Data    []byte
+	Path    string
+	Changed bool
+}
+
+// ValidateAndFix validates src with every registered check in priority
+// order and applies fixes according to opts, entirely in memory. Leave
+// opts.FixMode at checks.FixNone to only validate.
+//
+// The error mirrors the core validator: it is non-nil when ctx is cancelled
+// or when opts.HardFailOnErr escalates an ERROR. Report and FixedContent are
+// still populated with whatever ran up to that point.
+func ValidateAndFix(ctx context.Context, src Source, opts checks.RunOptions) (Report, FixedContent, error) {
+	return ValidateAndFixUnits(ctx, src, opts, checks.ListSorted())
+}
+
+// ValidateAndFixUnits is ValidateAndFix over an explicit check sequence,
+// e.g. one produced by ReorderNonCritical.
+func ValidateAndFixUnits(ctx context.Context, src Source, opts checks.RunOptions, units []checks.CheckUnit) (Report, FixedContent, error) {
+	rep, err := run(ctx, src, opts, units)
+
+	fixed := FixedContent{Data: src.Data, Path: src.Path}
+	if rep.AppliedFixes {
+		fixed = FixedContent{Data: rep.FinalData, Path: rep.FinalPath, Changed: true}
+	}
+
+	DropPayloads(&rep.Summary)
+	return rep, fixed, err
+}
+
+// DropPayloads releases the file contents a core summary holds onto; reports
+// only need counters and messages.
+func DropPayloads(sum *corevalidator.Summary) {
+	sum.FinalData = nil
+	for i := range sum.Outcomes {
+		sum.Outcomes[i].Final.Data = nil
+	}
+}
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const sample = "term;description;casesensitive;translatable;forbidden;tags;en\n" +
+	"API;application interface;yes;no;no;;API\n" +
+	"\n" +
+	"cart;shopping cart;no;yes;no;;cart\n"
+
+func TestValidateAndFix_FixesInMemory(t *testing.T) {
+	rep, fixed, err := ValidateAndFix(context.Background(), Source{
+		Path:  "glossary.csv",
+		Data:  []byte(sample),
+		Langs: []string{"en"},
+	}, checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rep.OK() {
+		t.Fatalf("expected report to pass, got fail=%d error=%d", rep.Fail, rep.Error)
+	}
+	if !fixed.Changed {
+		t.Fatal("expected the empty line to be fixed")
+	}
+	if bytes.Contains(fixed.Data, []byte("\n\n")) {
+		t.Errorf("fixed data still has an empty line: %q", fixed.Data)
+	}
+	if len(rep.Durations) != len(rep.Outcomes) {
+		t.Errorf("got %d durations for %d outcomes", len(rep.Durations), len(rep.Outcomes))
+	}
+	if rep.FinalData != nil {
+		t.Error("report should not carry the payload")
+	}
+	for _, o := range rep.Outcomes {
+		if o.Final.Data != nil {
+			t.Errorf("outcome %s still carries a payload", o.Result.Name)
+		}
+	}
+	if len(rep.Fixed) != len(rep.Outcomes) {
+		t.Fatalf("got %d fixed entries for %d outcomes", len(rep.Fixed), len(rep.Outcomes))
+	}
+	var last []byte
+	for i, o := range rep.Outcomes {
+		if (rep.Fixed[i] != nil) != o.Final.DidChange {
+			t.Errorf("outcome %s: changed=%v but fixed payload %q", o.Result.Name, o.Final.DidChange, rep.Fixed[i])
+		}
+		if rep.Fixed[i] != nil {
+			last = rep.Fixed[i]
+		}
+	}
+	if !bytes.Equal(last, fixed.Data) {
+		t.Errorf("last fix produced %q, fixed content is %q", last, fixed.Data)
+	}
+}
+
+func TestValidateAndFix_ValidateOnlyEchoesSource(t *testing.T) {
+	data := []byte(sample)
+	rep, fixed, err := ValidateAndFix(context.Background(), Source{
+		Path:  "glossary.csv",
+		Data:  data,
+		Langs: []string{"en"},
+	}, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fixed.Changed || !bytes.Equal(fixed.Data, data) || fixed.Path != "glossary.csv" {
+		t.Errorf("expected source to be echoed, got %+v", fixed)
+	}
+	if rep.Pass == 0 {
+		t.Error("expected some checks to pass")
+	}
+}
+
+func TestValidateAndFix_ListsSkippedChecks(t *testing.T) {
+	rep, _, err := ValidateAndFix(context.Background(), Source{
+		Path: "glossary.csv",
+		Data: []byte("term,description\nAPI,interface\n"),
+	}, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rep.EarlyExit || rep.EarlyCheck != "ensure-semicolon-separators" {
+		t.Fatalf("expected early exit at separators check, got %+v", rep.Summary)
+	}
+	if len(rep.Skipped) == 0 || rep.Skipped[0] != "no-spaces-in-header" {
+		t.Fatalf("unexpected skipped list: %v", rep.Skipped)
+	}
+	if got, want := len(rep.Outcomes)+len(rep.Skipped), len(checks.ListSorted()); got != want {
+		t.Errorf("ran + skipped = %d, want %d", got, want)
+	}
+}
+
+func TestValidateAndFix_ReportsEveryFinding(t *testing.T) {
+	data := "term;description;casesensitive;translatable;forbidden;tags;en\n" +
+		";x;no;no;no;;\n" +
+		"API;x;no;no;no;;API\n" +
+		";y;no;no;no;;\n"
+	rep, _, err := ValidateAndFix(context.Background(), Source{
+		Path:  "glossary.csv",
+		Data:  []byte(data),
+		Langs: []string{"en"},
+	}, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rep.Findings) != len(rep.Outcomes) {
+		t.Fatalf("got %d finding lists for %d outcomes", len(rep.Findings), len(rep.Outcomes))
+	}
+	for i, o := range rep.Outcomes {
+		if o.Result.Name != "no-empty-term-values" {
+			if o.Result.Status == checks.Pass && len(rep.Findings[i]) > 0 {
+				t.Errorf("%s passed but has findings", o.Result.Name)
+			}
+			continue
+		}
+		fds := rep.Findings[i]
+		if len(fds) != 2 {
+			t.Fatalf("want a finding per empty term, got %+v", fds)
+		}
+		for j, row := range []int{2, 4} {
+			f := fds[j]
+			if f.Row != row || f.Column != "term" || f.Message != "empty term" || f.Severity != checks.Fail {
+				t.Errorf("finding %d: got %+v", j, f)
+			}
+		}
+		return
+	}
+	t.Fatal("no-empty-term-values did not run")
+}